@@ -0,0 +1,9 @@
+# Fail early when the API key cannot list workflows.
+data "n8n_api_key_scopes" "current" {}
+
+check "api_key_can_list_workflows" {
+  assert {
+    condition     = contains(data.n8n_api_key_scopes.current.granted, "workflow:list")
+    error_message = "The n8n API key lacks scopes: ${join(", ", data.n8n_api_key_scopes.current.missing)}"
+  }
+}
@@ -0,0 +1,10 @@
+# Output what the Send Email node returned in an execution, rather than the
+# whole execution payload.
+data "n8n_execution_data" "order_confirmation" {
+  execution_id = "1042"
+  extract      = "$.data.resultData.runData['Send Email'][0].data.main[0]"
+}
+
+output "sent_emails" {
+  value = jsondecode(data.n8n_execution_data.order_confirmation.data)
+}
@@ -0,0 +1,12 @@
+# Validate the instance continuously, e.g. with health assessments.
+check "n8n_instance" {
+  data "n8n_instance_checks" "current" {
+    minimum_version      = "1.64.0"
+    active_workflows_tag = "terraform-managed"
+  }
+
+  assert {
+    condition     = data.n8n_instance_checks.current.passed
+    error_message = join("\n", data.n8n_instance_checks.current.failures)
+  }
+}
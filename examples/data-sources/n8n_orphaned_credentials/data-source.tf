@@ -0,0 +1,8 @@
+# Report credentials no workflow uses anymore.
+data "n8n_orphaned_credentials" "unused" {
+  credential_ids = var.credential_ids
+}
+
+output "unused_credential_ids" {
+  value = data.n8n_orphaned_credentials.unused.orphaned_ids
+}
@@ -0,0 +1,13 @@
+# Check that a workflow matches the export promoted from another environment.
+data "n8n_workflow_comparison" "promotion" {
+  workflow_id = "3LODqkaWPmYOi0FA"
+  export_json = file("${path.module}/workflows/order-sync.json")
+
+  ignore_paths = {
+    nodes = ["/*/position"]
+  }
+}
+
+output "order_sync_in_sync" {
+  value = data.n8n_workflow_comparison.promotion.in_sync
+}
@@ -0,0 +1,20 @@
+# Check that production workflows only use approved credentials.
+data "n8n_workflow_credentials" "production" {
+  tags = ["production"]
+}
+
+locals {
+  unapproved_credentials = distinct(flatten([
+    for workflow in values(data.n8n_workflow_credentials.production.workflows) : [
+      for credential in workflow.credentials : "${workflow.name}: ${credential.name}"
+      if !contains(var.approved_credential_ids, credential.id)
+    ]
+  ]))
+}
+
+check "approved_credentials" {
+  assert {
+    condition     = length(local.unapproved_credentials) == 0
+    error_message = "Unapproved credentials: ${join(", ", local.unapproved_credentials)}"
+  }
+}
@@ -0,0 +1,14 @@
+# Fail the plan when a workflow calls a sub-workflow that does not exist.
+data "n8n_workflow_dependencies" "all" {}
+
+check "sub_workflows_exist" {
+  assert {
+    condition     = length(data.n8n_workflow_dependencies.all.missing_ids) == 0
+    error_message = "Workflows call missing sub-workflows: ${join(", ", data.n8n_workflow_dependencies.all.missing_ids)}"
+  }
+}
+
+# Workflows to test again after changing the shared error handler.
+output "error_handler_callers" {
+  value = lookup(data.n8n_workflow_dependencies.all.dependents, n8n_workflow.error_handler.id, [])
+}
@@ -0,0 +1,14 @@
+# Instantiate a template from the n8n template library.
+data "n8n_workflow_template" "slack_alerts" {
+  template_id = 1750
+
+  credentials = {
+    slackApi = "42"
+  }
+}
+
+resource "n8n_workflow" "slack_alerts" {
+  name        = data.n8n_workflow_template.slack_alerts.name
+  nodes       = data.n8n_workflow_template.slack_alerts.nodes
+  connections = data.n8n_workflow_template.slack_alerts.connections
+}
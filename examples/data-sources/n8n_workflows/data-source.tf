@@ -1,2 +1,31 @@
 # List all workflows.
 data "n8n_workflows" "all" {}
+
+# List the workflows tagged nightly-batch, keyed by ID in workflows_by_id.
+data "n8n_workflows" "nightly" {
+  tags = ["nightly-batch"]
+}
+
+# Fail when several workflows share a name.
+check "no_duplicate_workflow_names" {
+  assert {
+    condition     = length(data.n8n_workflows.all.duplicate_names) == 0
+    error_message = "Duplicate workflow names: ${join(", ", keys(data.n8n_workflows.all.duplicate_names))}"
+  }
+}
+
+# Adopt every workflow of a project, leaving their content to the n8n editor.
+data "n8n_workflows" "team" {
+  project_id = "VmwOO9HeTikv4NvA"
+}
+
+import {
+  for_each = data.n8n_workflows.team.workflows_by_id
+  to       = n8n_workflow.team[each.key]
+  id       = each.key
+}
+
+resource "n8n_workflow" "team" {
+  for_each = data.n8n_workflows.team.workflows_by_id
+  name     = each.value.name
+}
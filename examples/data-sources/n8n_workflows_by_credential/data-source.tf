@@ -0,0 +1,8 @@
+# List the workflows to test again after rotating the Slack credential.
+data "n8n_workflows_by_credential" "slack" {
+  credential_id = var.slack_credential_id
+}
+
+output "workflows_to_retest" {
+  value = { for workflow in data.n8n_workflows_by_credential.slack.workflows : workflow.id => workflow.name }
+}
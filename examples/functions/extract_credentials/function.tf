@@ -0,0 +1,16 @@
+# Only allow approved credentials in a workflow.
+resource "n8n_workflow" "orders" {
+  name        = "Orders"
+  nodes       = file("${path.module}/orders/nodes.json")
+  connections = file("${path.module}/orders/connections.json")
+
+  lifecycle {
+    precondition {
+      condition = alltrue([
+        for credential in provider::n8n::extract_credentials(file("${path.module}/orders/nodes.json")) :
+        contains(var.approved_credential_ids, credential.id)
+      ])
+      error_message = "The Orders workflow uses credentials that are not approved."
+    }
+  }
+}
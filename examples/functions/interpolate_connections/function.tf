@@ -0,0 +1,22 @@
+# Prefix every node of a workflow with the environment name, keeping the
+# connections between the nodes consistent.
+variable "environment" {
+  type    = string
+  default = "staging"
+}
+
+locals {
+  template = jsondecode(file("${path.module}/workflows/orders.json"))
+
+  renames = {
+    for node in local.template.nodes : node.name => "${var.environment} ${node.name}"
+  }
+}
+
+resource "n8n_workflow" "orders" {
+  name = "Orders (${var.environment})"
+  nodes = jsonencode([
+    for node in local.template.nodes : merge(node, { name = local.renames[node.name] })
+  ])
+  connections = provider::n8n::interpolate_connections(jsonencode(local.template.connections), local.renames)
+}
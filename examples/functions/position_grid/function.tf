@@ -0,0 +1,20 @@
+# Lay out generated nodes on a single row, so that they are readable when
+# the workflow is opened in the n8n editor.
+locals {
+  steps = ["Fetch orders", "Filter paid", "Notify finance"]
+
+  nodes = [
+    for step in local.steps : {
+      name        = step
+      type        = "n8n-nodes-base.noOp"
+      typeVersion = 1
+      parameters  = {}
+    }
+  ]
+}
+
+resource "n8n_workflow" "pipeline" {
+  name        = "Order pipeline"
+  nodes       = provider::n8n::position_grid(jsonencode(local.nodes), length(local.nodes))
+  connections = jsonencode({})
+}
@@ -0,0 +1,9 @@
+# Back up a workflow without its credential names and IDs.
+data "n8n_workflow" "orders" {
+  workflow_id = "1xY2aB3cD4eF5gH6"
+}
+
+resource "local_file" "orders_backup" {
+  filename = "${path.module}/backup/orders.nodes.json"
+  content  = provider::n8n::strip_credentials(data.n8n_workflow.orders.nodes_json)
+}
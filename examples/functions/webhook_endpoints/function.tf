@@ -0,0 +1,15 @@
+# Fail the plan when two workflows of the configuration listen on the same
+# webhook endpoint, which n8n rejects when activating the second one.
+locals {
+  webhook_endpoints = flatten([
+    for workflow in [n8n_workflow.orders, n8n_workflow.refunds] :
+    provider::n8n::webhook_endpoints(workflow.nodes)
+  ])
+}
+
+check "unique_webhook_endpoints" {
+  assert {
+    condition     = length(local.webhook_endpoints) == length(distinct(local.webhook_endpoints))
+    error_message = "Webhook endpoints are used by several workflows: ${join(", ", local.webhook_endpoints)}"
+  }
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// AuditLog appends a JSON line to a file for every API call changing an
+// instance, so that changes made through the client can be correlated with
+// the audit trail of n8n. It is safe for concurrent use and can be shared by
+// clients of different instances.
+type AuditLog struct {
+	mu    sync.Mutex
+	file  *os.File
+	actor string
+}
+
+// AuditEntry is a line of an audit log.
+type AuditEntry struct {
+	Time   string `json:"time"`
+	Actor  string `json:"actor"`
+	Host   string `json:"host"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// Status is the status of the response, zero if none was received.
+	Status int `json:"status,omitempty"`
+
+	// Error is the error of a call that received no response.
+	Error string `json:"error,omitempty"`
+
+	// WorkflowID is the ID of the workflow changed by the call, if any.
+	WorkflowID string `json:"workflow_id,omitempty"`
+
+	// BeforeVersionID and AfterVersionID are the version of the workflow
+	// before and after the call, when known.
+	BeforeVersionID string `json:"before_version_id,omitempty"`
+	AfterVersionID  string `json:"after_version_id,omitempty"`
+}
+
+// workflowPathPattern matches the paths of the workflow endpoints, capturing
+// the workflow ID when the path has one.
+var workflowPathPattern = regexp.MustCompile(`/api/v1/workflows(?:/([^/]+))?`)
+
+// OpenAuditLog opens the audit log at the given path, creating the file
+// readable only by the current user if it does not exist. Entries are
+// attributed to actor, such as the name of the user running the client.
+func OpenAuditLog(name, actor string) (*AuditLog, error) {
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{file: file, actor: actor}, nil
+}
+
+// Close closes the audit log file.
+func (l *AuditLog) Close() error {
+	return l.file.Close()
+}
+
+// append writes an entry to the log, as a single write so that the lines of
+// processes sharing the file are not interleaved.
+func (l *AuditLog) append(entry AuditEntry) error {
+	entry.Actor = l.actor
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.file.Write(append(line, '\n'))
+	return err
+}
+
+// auditWorkflowID returns the ID of the workflow a request changes, empty for
+// requests creating a workflow, and whether the request is on a workflow
+// endpoint at all.
+func auditWorkflowID(req *http.Request) (string, bool) {
+	match := workflowPathPattern.FindStringSubmatch(req.URL.Path)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// auditedVersion returns the current version of the workflow changed by a
+// request, or an empty string if the request changes no existing workflow or
+// the workflow cannot be read.
+func (c *Client) auditedVersion(req *http.Request) string {
+	id, _ := auditWorkflowID(req)
+	if id == "" {
+		return ""
+	}
+	workflow, err := c.GetWorkflow(id)
+	if err != nil {
+		return ""
+	}
+	return workflow.VersionId
+}
+
+// audit appends the entry of a call changing the instance to Audit, given the
+// version of the changed workflow before the call. Successful response bodies
+// are read in full, for the workflow ID and version they hold, and replaced
+// so that the caller can still read them. Failures to write the entry are
+// ignored, since the call has been made either way.
+func (c *Client) audit(req *http.Request, beforeVersion string, res *http.Response, callErr error) {
+	entry := AuditEntry{
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		Host:            c.HostURL,
+		Method:          req.Method,
+		Path:            req.URL.Path,
+		BeforeVersionID: beforeVersion,
+	}
+	workflowID, workflowPath := auditWorkflowID(req)
+	entry.WorkflowID = workflowID
+
+	if callErr != nil {
+		entry.Error = callErr.Error()
+	}
+	if res != nil {
+		entry.Status = res.StatusCode
+	}
+
+	if res != nil && workflowPath && res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+		data, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(data))
+		if err == nil {
+			var workflow struct {
+				ID        string `json:"id"`
+				VersionId string `json:"versionId"`
+			}
+			if json.Unmarshal(data, &workflow) == nil {
+				if entry.WorkflowID == "" {
+					entry.WorkflowID = workflow.ID
+				}
+				entry.AfterVersionID = workflow.VersionId
+			}
+		}
+	}
+
+	_ = c.Audit.append(entry)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v1/workflows":
+			_, _ = w.Write([]byte(`{"id": "wf1", "name": "Orders", "versionId": "v1"}`))
+		case "GET /api/v1/workflows/wf1":
+			_, _ = w.Write([]byte(`{"id": "wf1", "name": "Orders", "versionId": "v1"}`))
+		case "PUT /api/v1/workflows/wf1":
+			_, _ = w.Write([]byte(`{"id": "wf1", "name": "Orders", "versionId": "v2"}`))
+		case "DELETE /api/v1/tags/t1":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	name := filepath.Join(t.TempDir(), "audit.jsonl")
+	require.NoError(t, os.WriteFile(name, []byte(`{"time": "earlier"}`+"\n"), 0o600))
+	audit, err := OpenAuditLog(name, "ci")
+	require.NoError(t, err)
+	defer audit.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+	client.Audit = audit
+
+	created, err := client.CreateWorkflow(&CreateWorkflowRequest{Name: "Orders"})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", created.VersionId, "the response body is still read after the audit")
+
+	_, err = client.GetWorkflow("wf1")
+	require.NoError(t, err)
+
+	_, err = client.UpdateWorkflow("wf1", &UpdateWorkflowRequest{Name: "Orders"})
+	require.NoError(t, err)
+
+	_, err = client.DeleteTag("t1")
+	require.Error(t, err)
+
+	file, err := os.Open(name)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+
+	// The existing entry is kept and reads are not audited
+	require.Len(t, entries, 4)
+	assert.Equal(t, "earlier", entries[0].Time)
+
+	assert.Equal(t, "POST", entries[1].Method)
+	assert.Equal(t, "ci", entries[1].Actor)
+	assert.Equal(t, ts.URL, entries[1].Host)
+	assert.Equal(t, http.StatusOK, entries[1].Status)
+	assert.Equal(t, "wf1", entries[1].WorkflowID)
+	assert.Empty(t, entries[1].BeforeVersionID)
+	assert.Equal(t, "v1", entries[1].AfterVersionID)
+	assert.NotEmpty(t, entries[1].Time)
+
+	assert.Equal(t, "PUT", entries[2].Method)
+	assert.Equal(t, "/api/v1/workflows/wf1", entries[2].Path)
+	assert.Equal(t, "v1", entries[2].BeforeVersionID)
+	assert.Equal(t, "v2", entries[2].AfterVersionID)
+
+	assert.Equal(t, "DELETE", entries[3].Method)
+	assert.Equal(t, http.StatusNotFound, entries[3].Status)
+	assert.Empty(t, entries[3].WorkflowID)
+}
@@ -11,17 +11,133 @@
 package n8n
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
+// ErrReadOnly is wrapped by the errors of requests refused by a read-only
+// client.
+var ErrReadOnly = errors.New("read-only client")
+
+// sharedTransport is the transport of every client created by NewClient, so
+// that connections to an instance are pooled across clients and reused by
+// concurrent calls instead of being opened for each call.
+var sharedTransport = newTransport()
+
+// newTransport returns a transport based on the default one, keeping more
+// idle connections per host since Terraform calls the API concurrently for
+// every workflow of a plan.
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = 32
+	transport.IdleConnTimeout = 90 * time.Second
+	return transport
+}
+
 // Client represents a client for the n8n service.
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+
+	// DumpDir, when set, is a directory where the payloads of every request
+	// and response are written, with secrets redacted, to debug API errors.
+	DumpDir string
+
+	// Tracer, when set, creates an OpenTelemetry span around every API call.
+	Tracer trace.Tracer
+
+	// TraceParent, when valid, is the parent of the spans created by Tracer,
+	// e.g. the span of the CI job running Terraform.
+	TraceParent trace.SpanContext
+
+	// ExcludePinnedData, when set, asks the API to leave the pinned data out
+	// of the workflows it returns. Pinned test data can be far larger than
+	// the rest of a workflow.
+	ExcludePinnedData bool
+
+	// ReadOnly, when set, fails requests other than GET with an error
+	// wrapping ErrReadOnly without sending them, as every other request of
+	// the API changes the instance.
+	ReadOnly bool
+
+	// Audit, when set, records every call changing the instance.
+	Audit *AuditLog
+
+	// metrics, when set, records the calls made by the client.
+	metrics *CallMetrics
+
+	// tags, when set, caches the IDs of tags resolved by ResolveTagIDs and
+	// ResolveOrCreateTagID. It is shared by the copies of the client.
+	tags *tagCache
+}
+
+// CallStats summarizes the API calls made by a client.
+type CallStats struct {
+	// Calls is the number of requests sent, including retries.
+	Calls int
+
+	// Retries is the number of requests sent again after a transient error.
+	Retries int
+
+	// RateLimited is the number of requests answered with 429 Too Many Requests.
+	RateLimited int
+
+	// Latency is the total time spent waiting for responses.
+	Latency time.Duration
+}
+
+// CallMetrics accumulates the statistics of the API calls made by the clients
+// recording into it. It is safe for concurrent use.
+type CallMetrics struct {
+	mu    sync.Mutex
+	stats CallStats
+}
+
+// Stats returns the statistics recorded so far.
+func (m *CallMetrics) Stats() CallStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stats
+}
+
+// record updates the recorded statistics. It does nothing on a nil receiver.
+func (m *CallMetrics) record(update func(stats *CallStats)) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	update(&m.stats)
+}
+
+// WithMetrics returns a copy of the client recording its calls in metrics.
+// Both clients share their connections.
+func (c *Client) WithMetrics(metrics *CallMetrics) *Client {
+	metered := *c
+	metered.metrics = metrics
+	return &metered
+}
+
+// WithPinnedData returns a copy of the client including the pinned data in
+// the workflows it returns, whatever ExcludePinnedData is set to on the
+// client. Both clients share their connections.
+func (c *Client) WithPinnedData() *Client {
+	withPinnedData := *c
+	withPinnedData.ExcludePinnedData = false
+	return &withPinnedData
 }
 
 // NewClient creates a new n8n client.
@@ -40,7 +156,8 @@ func NewClient(host *string, token *string) (*Client, error) {
 	}
 
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{Timeout: 10 * time.Second, Transport: sharedTransport},
+		tags:       &tagCache{},
 	}
 
 	c.HostURL = *host
@@ -49,25 +166,200 @@ func NewClient(host *string, token *string) (*Client, error) {
 	return &c, nil
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	token := c.Token
+// maxErrorBodySize limits how much of an error response body is included in
+// the returned error, since reverse proxies may answer with large HTML pages.
+const maxErrorBodySize = 4096
 
-	req.Header.Set("X-N8N-API-KEY", token)
+// secretValuePattern matches a key naming a secret followed by its value, as
+// found in JSON ("password": "x"), query strings (password=x) or messages
+// (password: x) echoed by error responses.
+var secretValuePattern = regexp.MustCompile(`(?i)((?:` + strings.Join(secretKeyFragments, "|") + `)[A-Za-z0-9_-]*"?\s*[:=]\s*"?)((?:bearer\s+)?[^"\s,&}\[{]+)`)
 
-	res, err := c.HTTPClient.Do(req)
+// bearerTokenPattern matches bearer tokens, such as the ones of Authorization
+// headers echoed by error responses.
+var bearerTokenPattern = regexp.MustCompile(`(?i)\b(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+
+// redactErrorBody replaces the secret values of an error response body, such
+// as credential fields or the API key echoed in validation messages, so that
+// the body can be shown in errors. The rest of the body, which holds the
+// validation details, is kept as it is.
+func redactErrorBody(body []byte, token string) []byte {
+	if token != "" {
+		body = bytes.ReplaceAll(body, []byte(token), []byte(redactedValue))
+	}
+	body = secretValuePattern.ReplaceAll(body, []byte("${1}"+redactedValue))
+	return bearerTokenPattern.ReplaceAll(body, []byte("${1}"+redactedValue))
+}
+
+// maxDrainedBodySize limits how much of an unread response body is discarded
+// to reuse its connection. Larger bodies are cheaper to drop with the
+// connection.
+const maxDrainedBodySize = 256 << 10
+
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	res, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
+	return io.ReadAll(res.Body)
+}
+
+// doJSONRequest sends a request and decodes the JSON response body into out
+// while it is read, so that large workflows are not buffered in memory before
+// being decoded.
+func (c *Client) doJSONRequest(req *http.Request, out interface{}) error {
+	res, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	// Drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainedBodySize))
+	return nil
+}
+
+// send authenticates and sends a request. Responses with a non-2xx status,
+// other than 304 to a conditional request, are turned into an error including
+// the beginning of the response body with secrets redacted, and their body is
+// closed.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.ReadOnly && req.Method != http.MethodGet {
+		return nil, fmt.Errorf("%w: %s %s was not sent", ErrReadOnly, req.Method, req.URL.Path)
+	}
+
+	// Calls changing the instance are audited with the workflow version
+	// they replace
+	audited := c.Audit != nil && req.Method != http.MethodGet
+	var beforeVersion string
+	if audited {
+		beforeVersion = c.auditedVersion(req)
+	}
+
+	req.Header.Set("X-N8N-API-KEY", c.Token)
+
+	req, endSpan := c.startSpan(req)
+	start := time.Now()
+	res, err := c.HTTPClient.Do(req)
+	latency := time.Since(start)
+	endSpan(res, err)
+	c.metrics.record(func(stats *CallStats) {
+		stats.Calls++
+		stats.Latency += latency
+		if err == nil && res.StatusCode == http.StatusTooManyRequests {
+			stats.RateLimited++
+		}
+	})
+	if audited {
+		c.audit(req, beforeVersion, res, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	if c.DumpDir != "" {
+		if err := c.dumpExchange(req, res); err != nil {
+			return nil, err
+		}
+	}
+
+	// Conditional requests are answered with 304 when nothing changed
+	notModified := res.StatusCode == http.StatusNotModified && req.Header.Get("If-None-Match") != ""
+
+	if !notModified && (res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices) {
+		defer res.Body.Close()
+		body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		if err != nil {
+			return nil, err
+		}
+		// Drain what remains of reasonably small bodies so that the
+		// connection can be reused
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainedBodySize))
+		statusErr := &statusError{statusCode: res.StatusCode, body: redactErrorBody(body, c.Token), feature: requiredLicense(res.StatusCode, req.URL.Path, body)}
+		if res.StatusCode == http.StatusForbidden && statusErr.feature == "" {
+			statusErr.scope = requiredScope(req.Method, req.URL.Path)
+		}
+		return nil, statusErr
+	}
+
+	return res, nil
+}
+
+// statusError is the error returned for responses with a non-2xx status.
+type statusError struct {
+	statusCode int
+	body       []byte
+
+	// scope is the API key scope the request required, for 403 responses.
+	scope string
+
+	// feature is the Enterprise feature the instance is not licensed for,
+	// for requests rejected for lack of a license.
+	feature string
+}
+
+func (e *statusError) Error() string {
+	message := fmt.Sprintf("status: %d, body: %s", e.statusCode, e.body)
+	if e.scope != "" {
+		message += fmt.Sprintf(" (the API key may be missing the %s scope required by this request)", e.scope)
 	}
+	if e.feature != "" {
+		message += fmt.Sprintf(" (the n8n instance is not licensed for %s)", e.feature)
+	}
+	return message
+}
+
+// pageRetries is how many times a page of a paginated list is requested again
+// after a transient error before the error is returned.
+const pageRetries = 4
+
+// pageRetryDelay is the delay before the first retry of a page, doubled for
+// each of the next ones.
+var pageRetryDelay = time.Second
+
+// getPage requests a page of a paginated list and decodes it into out. Pages
+// answered with a 502, 503 or 504, which reverse proxies return while n8n is
+// briefly unavailable, are requested again with an exponential backoff, so
+// that a long listing resumes from the page that failed rather than failing
+// as a whole.
+func (c *Client) getPage(endpoint string, out interface{}) error {
+	return c.getPageContext(context.Background(), endpoint, out)
+}
+
+// getPageContext is getPage with a context canceling the request and the
+// waits between retries.
+func (c *Client) getPageContext(ctx context.Context, endpoint string, out interface{}) error {
+	delay := pageRetryDelay
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		err = c.doJSONRequest(req, out)
+		var status *statusError
+		if err == nil || attempt == pageRetries || !errors.As(err, &status) || !transientStatus(status.statusCode) {
+			return err
+		}
+
+		c.metrics.record(func(stats *CallStats) { stats.Retries++ })
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
 
-	return body, err
+// transientStatus reports whether a response status indicates that the
+// instance is temporarily unavailable.
+func transientStatus(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
 }
@@ -62,13 +62,13 @@ func TestIntegrationCreateWorkflow(t *testing.T) {
 		},
 		Connections: map[string]Connection{},
 		Settings: Settings{
-			SaveExecutionProgress:    true,
-			SaveManualExecutions:     true,
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
-			ExecutionTimeout:         3600,
-			Timezone:                 "America/New_York",
-			ExecutionOrder:           "v1",
+			SaveExecutionProgress:    Ptr(true),
+			SaveManualExecutions:     Ptr(true),
+			SaveDataErrorExecution:   Ptr("all"),
+			SaveDataSuccessExecution: Ptr("all"),
+			ExecutionTimeout:         Ptr(3600),
+			Timezone:                 Ptr("America/New_York"),
+			ExecutionOrder:           Ptr("v1"),
 		},
 	}
 
@@ -130,20 +130,20 @@ func TestIntegrationCreateWorkflowWithMultiNodeConnections(t *testing.T) {
 		},
 		Connections: map[string]Connection{
 			"Start": {
-				Main: json.RawMessage(`[[{"node":"HTTP Request","type":"main","index":0}]]`),
+				"main": json.RawMessage(`[[{"node":"HTTP Request","type":"main","index":0}]]`),
 			},
 			"HTTP Request": {
-				Main: json.RawMessage(`[[{"node":"Set","type":"main","index":0}]]`),
+				"main": json.RawMessage(`[[{"node":"Set","type":"main","index":0}]]`),
 			},
 		},
 		Settings: Settings{
-			SaveExecutionProgress:    true,
-			SaveManualExecutions:     true,
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
-			ExecutionTimeout:         3600,
-			Timezone:                 "America/New_York",
-			ExecutionOrder:           "v1",
+			SaveExecutionProgress:    Ptr(true),
+			SaveManualExecutions:     Ptr(true),
+			SaveDataErrorExecution:   Ptr("all"),
+			SaveDataSuccessExecution: Ptr("all"),
+			ExecutionTimeout:         Ptr(3600),
+			Timezone:                 Ptr("America/New_York"),
+			ExecutionOrder:           Ptr("v1"),
 		},
 	}
 
@@ -180,13 +180,13 @@ func TestIntegrationUpdateWorkflow(t *testing.T) {
 		},
 		Connections: map[string]Connection{},
 		Settings: Settings{
-			SaveExecutionProgress:    true,
-			SaveManualExecutions:     true,
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
-			ExecutionTimeout:         3600,
-			Timezone:                 "America/New_York",
-			ExecutionOrder:           "v1",
+			SaveExecutionProgress:    Ptr(true),
+			SaveManualExecutions:     Ptr(true),
+			SaveDataErrorExecution:   Ptr("all"),
+			SaveDataSuccessExecution: Ptr("all"),
+			ExecutionTimeout:         Ptr(3600),
+			Timezone:                 Ptr("America/New_York"),
+			ExecutionOrder:           Ptr("v1"),
 		},
 	}
 
@@ -222,17 +222,17 @@ func TestIntegrationUpdateWorkflow(t *testing.T) {
 		},
 		Connections: map[string]Connection{
 			"Start": {
-				Main: json.RawMessage(`[[{"node":"Set Node","type":"main","index":0}]]`),
+				"main": json.RawMessage(`[[{"node":"Set Node","type":"main","index":0}]]`),
 			},
 		},
 		Settings: Settings{
-			SaveExecutionProgress:    true,
-			SaveManualExecutions:     true,
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
-			ExecutionTimeout:         3600,
-			Timezone:                 "America/New_York",
-			ExecutionOrder:           "v1",
+			SaveExecutionProgress:    Ptr(true),
+			SaveManualExecutions:     Ptr(true),
+			SaveDataErrorExecution:   Ptr("all"),
+			SaveDataSuccessExecution: Ptr("all"),
+			ExecutionTimeout:         Ptr(3600),
+			Timezone:                 Ptr("America/New_York"),
+			ExecutionOrder:           Ptr("v1"),
 		},
 	}
 
@@ -266,9 +266,9 @@ func TestIntegrationDeleteWorkflow(t *testing.T) {
 		}},
 		Connections: map[string]Connection{},
 		Settings: Settings{
-			ExecutionOrder:           "v1",
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
+			ExecutionOrder:           Ptr("v1"),
+			SaveDataErrorExecution:   Ptr("all"),
+			SaveDataSuccessExecution: Ptr("all"),
 		},
 	}
 	createdWorkflow, err := client.CreateWorkflow(newWorkflow)
@@ -308,9 +308,9 @@ func TestIntegrationActivateDeactivateWorkflow(t *testing.T) {
 		}},
 		Connections: map[string]Connection{},
 		Settings: Settings{
-			ExecutionOrder:           "v1",
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
+			ExecutionOrder:           Ptr("v1"),
+			SaveDataErrorExecution:   Ptr("all"),
+			SaveDataSuccessExecution: Ptr("all"),
 		},
 	}
 
@@ -6,10 +6,13 @@ package n8n
 import (
 	"errors"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // Custom RoundTripper to mock HTTPClient.
@@ -57,6 +60,57 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestNewClient_ReusesConnections(t *testing.T) {
+	var mu sync.Mutex
+	connections := 0
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message": "bad request"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "1"}`))
+	}))
+	ts.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			connections++
+			mu.Unlock()
+		}
+	}
+	ts.Start()
+	defer ts.Close()
+
+	// Clients of the same instance share their connections
+	token := "test-token"
+	first, err := NewClient(&ts.URL, &token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second, err := NewClient(&ts.URL, &token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		for _, client := range []*Client{first, second} {
+			if _, err := client.GetWorkflow("1"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			req, _ := http.NewRequest("GET", ts.URL+"/error", nil)
+			if _, err := client.doRequest(req); err == nil {
+				t.Fatal("expected error due to non-200 status code")
+			}
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if connections != 1 {
+		t.Errorf("expected a single connection to be reused, got %d", connections)
+	}
+}
+
 func TestNewClientWithoutToken(t *testing.T) {
 	host := "http://example.com"
 
@@ -208,3 +262,240 @@ func TestDoRequest_Non200StatusCode(t *testing.T) {
 		t.Errorf("expected body to be nil on non-200 response")
 	}
 }
+
+func TestDoRequest_CreatedStatusCode(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"1"}`)),
+		}, nil
+	})
+
+	req, _ := http.NewRequest("POST", client.HostURL+"/test", nil)
+
+	body, err := client.doRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error for 201 response: %v", err)
+	}
+
+	if string(body) != `{"id":"1"}` {
+		t.Errorf("unexpected body %q", string(body))
+	}
+}
+
+func TestDoJSONRequest(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"id": "1", "name": "Orders"}`)),
+		}, nil
+	})
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/test", nil)
+
+	var workflow Workflow
+	if err := client.doJSONRequest(req, &workflow); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if workflow.ID != "1" || workflow.Name != "Orders" {
+		t.Errorf("unexpected workflow %+v", workflow)
+	}
+}
+
+func TestDoJSONRequest_InvalidJSON(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`<html>`)),
+		}, nil
+	})
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/test", nil)
+
+	var workflow Workflow
+	err := client.doJSONRequest(req, &workflow)
+	if err == nil || !strings.Contains(err.Error(), "decoding response") {
+		t.Fatalf("expected decoding error, got: %v", err)
+	}
+}
+
+func TestDoRequest_LargeErrorBodyTruncated(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Body:       io.NopCloser(strings.NewReader(strings.Repeat("x", 10*maxErrorBodySize))),
+		}, nil
+	})
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/test", nil)
+
+	_, err := client.doRequest(req)
+	if err == nil {
+		t.Fatalf("expected error due to non-200 status code")
+	}
+	if len(err.Error()) > maxErrorBodySize+100 {
+		t.Errorf("expected error body to be truncated, got %d bytes", len(err.Error()))
+	}
+}
+
+func TestDoRequest_ErrorBodyRedacted(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "request/body/nodes/0/parameters must NOT have additional properties", "received": {"url": "https://example.com", "password":"hunter2-secret"}, "apiKey": "test-token"}`)),
+		}, nil
+	})
+
+	req, _ := http.NewRequest("POST", client.HostURL+"/api/v1/workflows", nil)
+
+	_, err := client.doRequest(req)
+	if err == nil {
+		t.Fatalf("expected error due to non-200 status code")
+	}
+	message := err.Error()
+	if !strings.Contains(message, "request/body/nodes/0/parameters must NOT have additional properties") || !strings.Contains(message, "https://example.com") {
+		t.Errorf("expected validation details in error, got %s", message)
+	}
+	if strings.Contains(message, "hunter2") || strings.Contains(message, "test-token") {
+		t.Errorf("expected secrets to be redacted, got %s", message)
+	}
+}
+
+func TestDoRequest_ReadOnly(t *testing.T) {
+	sent := 0
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	})
+	client.ReadOnly = true
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/api/v1/workflows/1", nil)
+	if _, err := client.doRequest(req); err != nil {
+		t.Fatalf("expected GET to be sent, got %s", err)
+	}
+
+	req, _ = http.NewRequest("DELETE", client.HostURL+"/api/v1/workflows/1", nil)
+	_, err := client.doRequest(req)
+	if !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "DELETE /api/v1/workflows/1") {
+		t.Errorf("expected the refused request in error, got %s", err)
+	}
+	if sent != 1 {
+		t.Errorf("expected 1 request sent, got %d", sent)
+	}
+}
+
+func TestRedactErrorBody(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{`{"password": "hunter2"}`, `{"password": "REDACTED"}`},
+		{`{"headerAuthorization":"Bearer abc.def"}`, `{"headerAuthorization":"REDACTED"}`},
+		{`invalid header Authorization: Bearer abc.def-123`, `invalid header Authorization: REDACTED`},
+		{`upstream rejected Bearer abc.def-123`, `upstream rejected Bearer REDACTED`},
+		{`GET /webhook?api_key=abc123&limit=1 failed`, `GET /webhook?api_key=REDACTED&limit=1 failed`},
+		{`{"message": "Invalid token provided", "tokens": ["a"]}`, `{"message": "Invalid token provided", "tokens": ["a"]}`},
+		{`key my-api-key is not allowed`, `key REDACTED is not allowed`},
+	}
+	for _, tt := range tests {
+		if got := string(redactErrorBody([]byte(tt.body), "my-api-key")); got != tt.expected {
+			t.Errorf("redactErrorBody(%q) = %q, want %q", tt.body, got, tt.expected)
+		}
+	}
+}
+
+func TestGetPage_RetriesTransientErrors(t *testing.T) {
+	defer func(delay time.Duration) { pageRetryDelay = delay }(pageRetryDelay)
+	pageRetryDelay = time.Millisecond
+
+	var cursors []string
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		cursor := req.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		switch {
+		case cursor == "":
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"data":[{"id":"1"}],"nextCursor":"abc"}`))}, nil
+		case len(cursors) < 4:
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("unavailable"))}, nil
+		default:
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"data":[{"id":"2"}],"nextCursor":null}`))}, nil
+		}
+	})
+
+	workflows, err := client.GetWorkflows()
+	if err != nil {
+		t.Fatalf("expected the failing page to be retried, got: %v", err)
+	}
+	if len(workflows.Data) != 2 {
+		t.Errorf("expected 2 workflows, got %d", len(workflows.Data))
+	}
+	if strings.Join(cursors, ",") != ",abc,abc,abc" {
+		t.Errorf("expected the listing to resume from the failing page, got cursors %q", cursors)
+	}
+}
+
+func TestGetPage_GivesUp(t *testing.T) {
+	defer func(delay time.Duration) { pageRetryDelay = delay }(pageRetryDelay)
+	pageRetryDelay = time.Millisecond
+
+	tests := []struct {
+		name     string
+		status   int
+		requests int
+	}{
+		{"transient error persists", http.StatusBadGateway, pageRetries + 1},
+		{"other errors are not retried", http.StatusInternalServerError, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := newMockClient(func(req *http.Request) (*http.Response, error) {
+				requests++
+				return &http.Response{StatusCode: tt.status, Body: io.NopCloser(strings.NewReader("error"))}, nil
+			})
+
+			var tags TagsResponse
+			if err := client.getPage(client.HostURL+"/api/v1/tags", &tags); err == nil {
+				t.Fatalf("expected an error")
+			}
+			if requests != tt.requests {
+				t.Errorf("expected %d requests, got %d", tt.requests, requests)
+			}
+		})
+	}
+}
+
+func TestWithMetrics(t *testing.T) {
+	defer func(delay time.Duration) { pageRetryDelay = delay }(pageRetryDelay)
+	pageRetryDelay = time.Millisecond
+
+	statuses := []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK}
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(`{"data":[],"nextCursor":null}`))}, nil
+	})
+
+	metrics := &CallMetrics{}
+	metered := client.WithMetrics(metrics)
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/test", nil)
+	if _, err := metered.doRequest(req); err == nil {
+		t.Fatalf("expected error due to rate limiting")
+	}
+	if _, err := metered.GetTags(); err != nil {
+		t.Fatalf("expected the unavailable page to be retried, got: %v", err)
+	}
+
+	stats := metrics.Stats()
+	if stats.Calls != 3 || stats.Retries != 1 || stats.RateLimited != 1 {
+		t.Errorf("expected 3 calls, 1 retry and 1 rate-limited call, got %+v", stats)
+	}
+	if client.metrics != nil {
+		t.Errorf("expected the original client to be left unmetered")
+	}
+}
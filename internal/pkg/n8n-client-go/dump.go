@@ -0,0 +1,111 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// redactedValue replaces the values of secret fields in dumped payloads.
+const redactedValue = "REDACTED"
+
+// secretKeyFragments are the lowercase fragments of the object keys whose
+// values are redacted from dumped payloads, such as API keys or passwords
+// hardcoded in node parameters.
+var secretKeyFragments = []string{"password", "secret", "token", "apikey", "api_key", "authorization", "privatekey", "private_key", "accesskey", "access_key"}
+
+// dumpSequence orders the dump files written by the process.
+var dumpSequence atomic.Uint64
+
+// unsafeFileChars matches the characters of a request path that are not kept
+// in dump file names.
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// dumpExchange writes the request and response payloads of an API call to
+// DumpDir, with secrets redacted. The response body is read in full and
+// replaced so that the caller can still read it. Failures to write the dump
+// are ignored, since they must not break the call being debugged.
+func (c *Client) dumpExchange(req *http.Request, res *http.Response) error {
+	prefix := fmt.Sprintf("%s-%04d-%s-%s",
+		time.Now().UTC().Format("20060102T150405"),
+		dumpSequence.Add(1),
+		req.Method,
+		strings.Trim(unsafeFileChars.ReplaceAllString(req.URL.Path, "_"), "_"),
+	)
+
+	dumpDirReady := os.MkdirAll(c.DumpDir, 0o700) == nil
+
+	if dumpDirReady && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			writeDump(filepath.Join(c.DumpDir, prefix+".request.json"), data)
+		}
+	}
+
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(data))
+
+	if dumpDirReady {
+		writeDump(filepath.Join(c.DumpDir, fmt.Sprintf("%s.response.%d.json", prefix, res.StatusCode)), data)
+	}
+	return nil
+}
+
+// writeDump writes a payload to a file readable only by the current user,
+// redacting secrets from JSON payloads. Other payloads, such as HTML error
+// pages, are written as they are.
+func writeDump(name string, data []byte) {
+	var payload interface{}
+	if err := json.Unmarshal(data, &payload); err == nil {
+		if redacted, err := json.MarshalIndent(redactSecrets(payload), "", "  "); err == nil {
+			data = redacted
+		}
+	}
+	_ = os.WriteFile(name, data, 0o600)
+}
+
+// redactSecrets replaces the values of secret fields in a decoded JSON value.
+func redactSecrets(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			if isSecretKey(key) {
+				value[key] = redactedValue
+			} else {
+				value[key] = redactSecrets(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range value {
+			value[i] = redactSecrets(nested)
+		}
+	}
+	return value
+}
+
+// isSecretKey reports whether an object key names a secret.
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, fragment := range secretKeyFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpDir(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "propertyValues[itemName] is not iterable"}`))
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+	client.DumpDir = filepath.Join(t.TempDir(), "dumps")
+
+	_, err = client.CreateWorkflow(&CreateWorkflowRequest{
+		Name: "Orders",
+		Nodes: []Node{{Name: "HTTP", Parameters: map[string]interface{}{
+			"url":       "https://example.com",
+			"authToken": "s3cr3t",
+		}}},
+	})
+	require.ErrorContains(t, err, "not iterable", "the response body is still read after the dump")
+
+	entries, err := os.ReadDir(client.DumpDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	var request, response string
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(client.DumpDir, entry.Name()))
+		require.NoError(t, err)
+		switch {
+		case strings.HasSuffix(entry.Name(), ".request.json"):
+			request = string(data)
+		case strings.HasSuffix(entry.Name(), ".response.400.json"):
+			response = string(data)
+		}
+		assert.Contains(t, entry.Name(), "-POST-api_v1_workflows.")
+	}
+
+	assert.Contains(t, request, "https://example.com")
+	assert.Contains(t, request, redactedValue)
+	assert.NotContains(t, request, "s3cr3t")
+	assert.NotContains(t, request, token)
+	assert.Contains(t, response, "not iterable")
+}
+
+func TestRedactSecrets(t *testing.T) {
+	value := map[string]interface{}{
+		"name": "Orders",
+		"nodes": []interface{}{
+			map[string]interface{}{"parameters": map[string]interface{}{"password": "hunter2", "headerAuthorization": "Bearer x", "path": "orders"}},
+		},
+	}
+
+	redacted := redactSecrets(value).(map[string]interface{})
+	parameters := redacted["nodes"].([]interface{})[0].(map[string]interface{})["parameters"].(map[string]interface{})
+	assert.Equal(t, redactedValue, parameters["password"])
+	assert.Equal(t, redactedValue, parameters["headerAuthorization"])
+	assert.Equal(t, "orders", parameters["path"])
+	assert.Equal(t, "Orders", redacted["name"])
+}
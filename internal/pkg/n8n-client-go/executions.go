@@ -0,0 +1,91 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// GetLastExecution retrieves the most recent execution of a workflow.
+//
+// Parameters:
+//   - workflowID: the unique identifier of the workflow.
+//
+// Returns the Execution, nil if the workflow never ran, or an error if the
+// request or response decoding fails.
+func (c *Client) GetLastExecution(workflowID string) (*Execution, error) {
+	query := url.Values{}
+	query.Set("workflowId", workflowID)
+	query.Set("limit", "1")
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/executions?%s", c.HostURL, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var executions ExecutionsResponse
+	if err := c.doJSONRequest(req, &executions); err != nil {
+		return nil, err
+	}
+
+	if len(executions.Data) == 0 {
+		return nil, nil
+	}
+	return &executions.Data[0], nil
+}
+
+// GetExecution retrieves an execution by its ID, along with the data of its
+// node runs in Execution.Data.
+//
+// Parameters:
+//   - executionID: the unique identifier of the execution.
+//
+// Returns the Execution, or an error if the request or decoding fails.
+func (c *Client) GetExecution(executionID string) (*Execution, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/executions/%s?includeData=true", c.HostURL, url.PathEscape(executionID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var execution Execution
+	if err := c.doJSONRequest(req, &execution); err != nil {
+		return nil, err
+	}
+	return &execution, nil
+}
+
+// GetFailedExecutionsSince retrieves the executions that ended in an error and
+// started at or after since, newest first. Pages are requested until an
+// execution older than since is found.
+func (c *Client) GetFailedExecutionsSince(since time.Time) ([]Execution, error) {
+	query := url.Values{}
+	query.Set("status", "error")
+
+	var failed []Execution
+	for {
+		var executions ExecutionsResponse
+		if err := c.getPage(fmt.Sprintf("%s/api/v1/executions?%s", c.HostURL, query.Encode()), &executions); err != nil {
+			return nil, err
+		}
+
+		for _, execution := range executions.Data {
+			startedAt, err := time.Parse(time.RFC3339, execution.StartedAt)
+			if err != nil {
+				return nil, fmt.Errorf("execution %s has an invalid start time %q: %w", execution.ID, execution.StartedAt, err)
+			}
+			if startedAt.Before(since) {
+				return failed, nil
+			}
+			failed = append(failed, execution)
+		}
+
+		if executions.NextCursor == nil {
+			return failed, nil
+		}
+		query.Set("cursor", *executions.NextCursor)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLastExecution(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/executions", r.URL.Path)
+		require.Equal(t, "wf1", r.URL.Query().Get("workflowId"))
+		require.Equal(t, "1", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(`{"data": [{"id": 42, "workflowId": "wf1", "finished": true, "mode": "trigger", "status": "success", "startedAt": "2025-01-01T00:00:00.000Z", "stoppedAt": "2025-01-01T00:00:01.000Z"}], "nextCursor": "abc"}`))
+	})
+
+	execution, err := client.GetLastExecution("wf1")
+	require.NoError(t, err)
+	require.Equal(t, &Execution{
+		ID:         "42",
+		WorkflowID: "wf1",
+		Finished:   true,
+		Mode:       "trigger",
+		Status:     "success",
+		StartedAt:  "2025-01-01T00:00:00.000Z",
+		StoppedAt:  "2025-01-01T00:00:01.000Z",
+	}, execution)
+}
+
+func TestGetLastExecution_NeverRan(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	})
+
+	execution, err := client.GetLastExecution("wf1")
+	require.NoError(t, err)
+	require.Nil(t, execution)
+}
+
+func TestGetFailedExecutionsSince(t *testing.T) {
+	pages := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/executions", r.URL.Path)
+		require.Equal(t, "error", r.URL.Query().Get("status"))
+		pages++
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = w.Write([]byte(`{"data": [{"id": 3, "workflowId": "wf1", "status": "error", "startedAt": "2025-01-01T10:50:00.000Z"}], "nextCursor": "abc"}`))
+			return
+		}
+		require.Equal(t, "abc", r.URL.Query().Get("cursor"))
+		_, _ = w.Write([]byte(`{"data": [{"id": 2, "workflowId": "wf2", "status": "error", "startedAt": "2025-01-01T10:10:00.000Z"}, {"id": 1, "workflowId": "wf1", "status": "error", "startedAt": "2025-01-01T09:00:00.000Z"}], "nextCursor": "def"}`))
+	})
+
+	failed, err := client.GetFailedExecutionsSince(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, failed, 2)
+	require.Equal(t, "3", failed[0].ID.String())
+	require.Equal(t, "2", failed[1].ID.String())
+	require.Equal(t, 2, pages, "pages after the first older execution are not requested")
+}
+
+func TestGetExecution(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/executions/42", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("includeData"))
+		_, _ = w.Write([]byte(`{"id": 42, "workflowId": "wf1", "status": "success", "data": {"resultData": {"runData": {}}}}`))
+	})
+
+	execution, err := client.GetExecution("42")
+	require.NoError(t, err)
+	require.Equal(t, "success", execution.Status)
+	require.JSONEq(t, `{"resultData": {"runData": {}}}`, string(execution.Data))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// licenseFeatures maps the license features named in the errors of n8n to the
+// Enterprise feature they enable.
+var licenseFeatures = map[string]string{
+	"feat:variables":           "Variables",
+	"feat:projectRole:admin":   "Projects",
+	"feat:projectRole:editor":  "Projects",
+	"feat:projectRole:viewer":  "Projects",
+	"feat:advancedPermissions": "Projects",
+	"feat:logStreaming":        "Log streaming",
+	"feat:sourceControl":       "Source control",
+	"feat:externalSecrets":     "External secrets",
+}
+
+// licensedCollections maps the collections of the public API only available
+// with an Enterprise license to the feature they belong to, for errors that
+// do not name the license feature.
+var licensedCollections = map[string]string{
+	"variables":      "Variables",
+	"projects":       "Projects",
+	"source-control": "Source control",
+}
+
+// licenseFeaturePattern matches the license features named in errors, such as
+// "Your license does not allow for feat:variables".
+var licenseFeaturePattern = regexp.MustCompile(`feat:[A-Za-z]+(:[A-Za-z]+)?`)
+
+// requiredLicense returns the Enterprise feature a request to the given path
+// answered with the given status and body was rejected for, or an empty
+// string if it was not rejected for lack of a license. n8n answers such
+// requests with a 403, or a 404 for routes it does not register without the
+// license.
+func requiredLicense(statusCode int, path string, body []byte) string {
+	if statusCode != http.StatusForbidden && statusCode != http.StatusNotFound {
+		return ""
+	}
+	if !strings.Contains(strings.ToLower(string(body)), "license") {
+		return ""
+	}
+
+	if feature, ok := licenseFeatures[licenseFeaturePattern.FindString(string(body))]; ok {
+		return feature
+	}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, "/api/v1"), "/"), "/")
+	if feature, ok := licensedCollections[segments[0]]; ok {
+		return feature
+	}
+	return "an Enterprise feature"
+}
+
+// RequiredLicense returns the Enterprise feature, such as "Variables" or
+// "Projects", that the n8n instance is not licensed for if a request failed
+// with err for lack of a license.
+func RequiredLicense(err error) (string, bool) {
+	var status *statusError
+	if !errors.As(err, &status) || status.feature == "" {
+		return "", false
+	}
+	return status.feature, true
+}
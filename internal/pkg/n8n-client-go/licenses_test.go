@@ -0,0 +1,95 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequiredLicense(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		path       string
+		body       string
+		feature    string
+	}{
+		{
+			name:       "named feature",
+			statusCode: http.StatusForbidden,
+			path:       "/api/v1/variables",
+			body:       `{"message":"Your license does not allow for feat:variables. To enable feat:variables, please upgrade to a license that supports this feature."}`,
+			feature:    "Variables",
+		},
+		{
+			name:       "project role",
+			statusCode: http.StatusForbidden,
+			path:       "/api/v1/projects",
+			body:       `{"message":"Your license does not allow for feat:projectRole:admin."}`,
+			feature:    "Projects",
+		},
+		{
+			name:       "unnamed feature of a licensed collection",
+			statusCode: http.StatusNotFound,
+			path:       "/api/v1/projects/1/users",
+			body:       `{"message":"Not available with your license"}`,
+			feature:    "Projects",
+		},
+		{
+			name:       "unknown feature",
+			statusCode: http.StatusForbidden,
+			path:       "/api/v1/workflows/1/transfer",
+			body:       `{"message":"Plan lacks license for this feature"}`,
+			feature:    "an Enterprise feature",
+		},
+		{
+			name:       "missing scope",
+			statusCode: http.StatusForbidden,
+			path:       "/api/v1/variables",
+			body:       `{"message":"Forbidden"}`,
+		},
+		{
+			name:       "other status",
+			statusCode: http.StatusBadRequest,
+			path:       "/api/v1/variables",
+			body:       `{"message":"Your license does not allow for feat:variables."}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if feature := requiredLicense(tt.statusCode, tt.path, []byte(tt.body)); feature != tt.feature {
+				t.Errorf("expected feature %q, got %q", tt.feature, feature)
+			}
+		})
+	}
+}
+
+func TestRequiredLicenseError(t *testing.T) {
+	body := `{"message":"Your license does not allow for feat:variables."}`
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusForbidden, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+
+	_, err := client.GetVariables()
+	feature, ok := RequiredLicense(err)
+	if !ok || feature != "Variables" {
+		t.Fatalf("expected required license Variables, got %q (%v)", feature, err)
+	}
+	if _, ok := MissingScope(err); ok {
+		t.Errorf("expected no missing scope for a license error")
+	}
+	if !strings.Contains(err.Error(), "not licensed for Variables") {
+		t.Errorf("expected the error to name the feature, got: %v", err)
+	}
+
+	body = `{"message":"Forbidden"}`
+	_, err = client.GetVariables()
+	if _, ok := RequiredLicense(err); ok {
+		t.Errorf("expected no required license for a missing scope, got: %v", err)
+	}
+}
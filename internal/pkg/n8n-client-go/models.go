@@ -43,6 +43,10 @@ type Workflow struct {
 	Tags []Tag `json:"tags"`
 	// PinData      interface{}           `json:"pinData"`  // TODO understand how this parameter is used and make it exportable to the state
 	// StaticData   interface{}           `json:"staticData"` // TODO understand how this parameter is used and make it exportable to the state
+
+	// Extra holds the fields not listed above, such as the ones added by newer
+	// n8n versions.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // WorkflowsResponse represents a paginated response from an API call
@@ -57,6 +61,37 @@ type WorkflowsResponse struct {
 	NextCursor *string `json:"nextCursor"`
 }
 
+// WorkflowTemplateResponse represents the response of the template library
+// when retrieving a single workflow template.
+type WorkflowTemplateResponse struct {
+	// Workflow is the requested template.
+	Workflow WorkflowTemplate `json:"workflow"`
+}
+
+// WorkflowTemplate represents a workflow published in the n8n template library.
+type WorkflowTemplate struct {
+	// ID is the unique identifier of the template.
+	ID int64 `json:"id"`
+
+	// Name is the name of the template.
+	Name string `json:"name"`
+
+	// Description is the Markdown description of the template.
+	Description string `json:"description"`
+
+	// Workflow holds the nodes and connections of the template.
+	Workflow WorkflowTemplateContent `json:"workflow"`
+}
+
+// WorkflowTemplateContent holds the nodes and connections of a workflow template.
+type WorkflowTemplateContent struct {
+	// Nodes is the list of nodes of the template.
+	Nodes []Node `json:"nodes"`
+
+	// Connections maps node names to their connections.
+	Connections map[string]Connection `json:"connections"`
+}
+
 // Tag represents a label assigned to a workflow for organizational purposes.
 type Tag struct {
 	// CreatedAt is the timestamp when the tag was created.
@@ -72,13 +107,117 @@ type Tag struct {
 	Name string `json:"name"`
 }
 
-// Connection represents the connections from a node to other nodes within a workflow.
-type Connection struct {
-	// Main holds the raw connection data. It should be further structured for improved type safety.
-	// TODO: Find a way to transform this into a concrete struct.
-	Main json.RawMessage `json:"main"`
+// TagsResponse represents a paginated response from an API call
+// that returns a list of tags.
+type TagsResponse struct {
+	// Data contains the list of tags returned in the response.
+	Data []Tag `json:"data"`
+
+	// NextCursor is an optional cursor string used for pagination.
+	// It is nil when there are no additional pages.
+	NextCursor *string `json:"nextCursor"`
+}
+
+// Execution represents a run of a workflow.
+type Execution struct {
+	// ID is the unique identifier of the execution. The API returns it as a
+	// number, unlike workflow and tag IDs.
+	ID json.Number `json:"id"`
+
+	// WorkflowID is the identifier of the executed workflow.
+	WorkflowID string `json:"workflowId"`
+
+	// Finished indicates whether the execution completed.
+	Finished bool `json:"finished"`
+
+	// Mode is how the execution was started, e.g. trigger, webhook or manual.
+	Mode string `json:"mode"`
+
+	// Status is the outcome of the execution, e.g. success, error, running or waiting.
+	Status string `json:"status"`
+
+	// StartedAt is the timestamp when the execution started.
+	StartedAt string `json:"startedAt"`
+
+	// StoppedAt is the timestamp when the execution stopped, empty while running.
+	StoppedAt string `json:"stoppedAt"`
+
+	// Data holds the input and output of every node run by the execution,
+	// only returned when requested as it can weigh several megabytes.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// ExecutionsResponse represents a paginated response from an API call
+// that returns a list of executions.
+type ExecutionsResponse struct {
+	// Data contains the list of executions returned in the response.
+	Data []Execution `json:"data"`
+
+	// NextCursor is an optional cursor string used for pagination.
+	// It is nil when there are no additional pages.
+	NextCursor *string `json:"nextCursor"`
+}
+
+// CreateTagRequest defines the allowed fields when creating a tag.
+type CreateTagRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateTagRequest defines the allowed fields when renaming a tag.
+type UpdateTagRequest struct {
+	Name string `json:"name"`
+}
+
+// Variable represents an instance variable, available to workflows as $vars.
+type Variable struct {
+	// ID is the unique identifier of the variable.
+	ID string `json:"id"`
+
+	// Key is the name of the variable.
+	Key string `json:"key"`
+
+	// Value is the value of the variable.
+	Value string `json:"value"`
+
+	// Type is the type of the variable, e.g. "string".
+	Type string `json:"type,omitempty"`
+}
+
+// VariablesResponse represents the response returned when listing variables.
+type VariablesResponse struct {
+	// Data contains the list of variables returned in the response.
+	Data []Variable `json:"data"`
+
+	// NextCursor is an optional cursor string used for pagination.
+	// It is nil when there are no additional pages.
+	NextCursor *string `json:"nextCursor"`
+}
+
+// CreateVariableRequest defines the allowed fields when creating a variable.
+type CreateVariableRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// UpdateVariableRequest defines the allowed fields when updating a variable.
+type UpdateVariableRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
+// TagReference references an existing tag by its ID, e.g. when assigning
+// tags to a workflow.
+type TagReference struct {
+	ID string `json:"id"`
+}
+
+// Connection represents the connections from a node to other nodes within a
+// workflow, by connection type: main for the regular outputs, and types such
+// as ai_tool, ai_languageModel or ai_memory for the nodes wired to AI agents.
+// The outputs of each type are kept raw so that every type is sent back
+// unchanged.
+type Connection map[string]json.RawMessage
+
 // ConnectionDetail provides detailed information about a specific connection between nodes.
 type ConnectionDetail struct {
 	// Node is the identifier of the target node in the connection.
@@ -114,18 +253,229 @@ type Node struct {
 	// Credentials holds node-specific credential references for authentication.
 	// This field is optional and only present for nodes that require credentials.
 	Credentials map[string]interface{} `json:"credentials,omitempty"`
+
+	// Disabled indicates whether the node is skipped when the workflow runs.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Notes is the documentation attached to the node in the editor.
+	Notes string `json:"notes,omitempty"`
+
+	// NotesInFlow indicates whether the notes are displayed on the canvas.
+	NotesInFlow bool `json:"notesInFlow,omitempty"`
+	// Extra holds the fields not listed above, such as webhookId, onError or
+	// retryOnFail, so that they are sent back unchanged on update.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Settings contains global execution settings for a workflow.
+//
+// Fields are pointers so that settings which are not set are left out of
+// requests, letting n8n keep its current value or the instance default, rather
+// than being sent as zero values. The getters return the zero value of unset
+// settings.
 type Settings struct {
-	SaveExecutionProgress    bool   `json:"saveExecutionProgress"`
-	SaveManualExecutions     bool   `json:"saveManualExecutions"`
-	SaveDataErrorExecution   string `json:"saveDataErrorExecution"`   // Enum: "all", "none"
-	SaveDataSuccessExecution string `json:"saveDataSuccessExecution"` // Enum: "all", "none"
-	ExecutionTimeout         int    `json:"executionTimeout"`         // maxLength: 3600
-	ErrorWorkflow            string `json:"errorWorkflow"`
-	Timezone                 string `json:"timezone"`
-	ExecutionOrder           string `json:"executionOrder"`
+	SaveExecutionProgress    *bool   `json:"saveExecutionProgress,omitempty"`
+	SaveManualExecutions     *bool   `json:"saveManualExecutions,omitempty"`
+	SaveDataErrorExecution   *string `json:"saveDataErrorExecution,omitempty"`   // Enum: "all", "none"
+	SaveDataSuccessExecution *string `json:"saveDataSuccessExecution,omitempty"` // Enum: "all", "none"
+	ExecutionTimeout         *int    `json:"executionTimeout,omitempty"`         // maxLength: 3600, -1 for no timeout
+	ErrorWorkflow            *string `json:"errorWorkflow,omitempty"`
+	Timezone                 *string `json:"timezone,omitempty"`
+	ExecutionOrder           *string `json:"executionOrder,omitempty"`
+
+	// Extra holds the settings not listed above, such as the ones added by newer
+	// n8n versions, so that they are sent back unchanged on update.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Ptr returns a pointer to v, to set the optional fields of settings.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// value returns the value of an optional field, or its zero value when it is
+// not set.
+func value[T any](field *T) T {
+	if field == nil {
+		var zero T
+		return zero
+	}
+	return *field
+}
+
+// GetSaveExecutionProgress returns the SaveExecutionProgress setting, or false when it is not set.
+func (s Settings) GetSaveExecutionProgress() bool { return value(s.SaveExecutionProgress) }
+
+// GetSaveManualExecutions returns the SaveManualExecutions setting, or false when it is not set.
+func (s Settings) GetSaveManualExecutions() bool { return value(s.SaveManualExecutions) }
+
+// GetSaveDataErrorExecution returns the SaveDataErrorExecution setting, or "" when it is not set.
+func (s Settings) GetSaveDataErrorExecution() string { return value(s.SaveDataErrorExecution) }
+
+// GetSaveDataSuccessExecution returns the SaveDataSuccessExecution setting, or "" when it is not set.
+func (s Settings) GetSaveDataSuccessExecution() string { return value(s.SaveDataSuccessExecution) }
+
+// GetExecutionTimeout returns the ExecutionTimeout setting, or 0 when it is not set.
+func (s Settings) GetExecutionTimeout() int { return value(s.ExecutionTimeout) }
+
+// GetErrorWorkflow returns the ErrorWorkflow setting, or "" when it is not set.
+func (s Settings) GetErrorWorkflow() string { return value(s.ErrorWorkflow) }
+
+// GetTimezone returns the Timezone setting, or "" when it is not set.
+func (s Settings) GetTimezone() string { return value(s.Timezone) }
+
+// GetExecutionOrder returns the ExecutionOrder setting, or "" when it is not set.
+func (s Settings) GetExecutionOrder() string { return value(s.ExecutionOrder) }
+
+// settingsFields is Settings without its JSON methods.
+type settingsFields Settings
+
+// UnmarshalJSON decodes the known settings into their fields and keeps the
+// others in Extra.
+func (s *Settings) UnmarshalJSON(data []byte) error {
+	var fields settingsFields
+	extra, err := unmarshalWithExtra(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	*s = Settings(fields)
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the known settings along with the ones in Extra. Known
+// settings take precedence over Extra entries with the same key.
+func (s Settings) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(settingsFields(s), s.Extra)
+}
+
+// workflowFields is Workflow without its JSON methods.
+type workflowFields Workflow
+
+// UnmarshalJSON decodes the known workflow fields and keeps the others in
+// Extra.
+func (w *Workflow) UnmarshalJSON(data []byte) error {
+	var fields workflowFields
+	extra, err := unmarshalWithExtra(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	*w = Workflow(fields)
+	w.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the known workflow fields along with the ones in Extra.
+func (w Workflow) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(workflowFields(w), w.Extra)
+}
+
+// writableWorkflowFields lists the workflow fields not modeled by
+// CreateWorkflowRequest and UpdateWorkflowRequest that the create and update
+// endpoints accept. The API validates request bodies against its schema and
+// rejects the read-only fields it returns, such as shared, isArchived or meta.
+var writableWorkflowFields = []string{"staticData", "pinData"}
+
+// WritableExtra returns the fields of Extra that the create and update
+// endpoints accept, to send them back along with a workflow read from the
+// API, or nil if there are none.
+func (w *Workflow) WritableExtra() map[string]json.RawMessage {
+	var writable map[string]json.RawMessage
+	for _, key := range writableWorkflowFields {
+		if value, ok := w.Extra[key]; ok {
+			if writable == nil {
+				writable = make(map[string]json.RawMessage)
+			}
+			writable[key] = value
+		}
+	}
+	return writable
+}
+
+// nodeFields is Node without its JSON methods.
+type nodeFields Node
+
+// UnmarshalJSON decodes the known node fields and keeps the others in Extra.
+func (n *Node) UnmarshalJSON(data []byte) error {
+	var fields nodeFields
+	extra, err := unmarshalWithExtra(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	*n = Node(fields)
+	n.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the known node fields along with the ones in Extra.
+func (n Node) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(nodeFields(n), n.Extra)
+}
+
+// unmarshalWithExtra decodes data into fields, a pointer to a struct, and
+// returns the keys of data that are not fields of the struct, or nil if there
+// are none.
+func unmarshalWithExtra(data []byte, fields interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, fields); err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	known, err := encodedFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	for key := range known {
+		delete(all, key)
+	}
+
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return all, nil
+}
+
+// marshalWithExtra encodes fields, a struct, along with the entries of extra.
+// Fields take precedence over extra entries with the same key.
+func marshalWithExtra(fields interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	if len(extra) == 0 {
+		return json.Marshal(fields)
+	}
+
+	known, err := encodedFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	all := make(map[string]json.RawMessage, len(extra)+len(known))
+	for key, value := range extra {
+		all[key] = value
+	}
+	for key, value := range known {
+		all[key] = value
+	}
+	return json.Marshal(all)
+}
+
+// encodedFields returns the encoded fields of a struct by JSON key.
+func encodedFields(fields interface{}) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(data, &known); err != nil {
+		return nil, err
+	}
+	return known, nil
 }
 
 // CreateWorkflowRequest defines the allowed fields when creating a workflow.
@@ -135,6 +485,17 @@ type CreateWorkflowRequest struct {
 	Connections map[string]Connection `json:"connections"`
 	Settings    Settings              `json:"settings"`
 	// StaticData   interface{}           `json:"staticData"` // TODO understand how this parameter is used and make it exportable to the state
+
+	// Extra holds workflow fields not listed above, sent along with them.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// createWorkflowFields is CreateWorkflowRequest without its JSON methods.
+type createWorkflowFields CreateWorkflowRequest
+
+// MarshalJSON encodes the request fields along with the ones in Extra.
+func (r CreateWorkflowRequest) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(createWorkflowFields(r), r.Extra)
 }
 
 // UpdateWorkflowRequest defines the allowed fields when updating a workflow.
@@ -144,4 +505,15 @@ type UpdateWorkflowRequest struct {
 	Connections map[string]Connection `json:"connections"`
 	Settings    Settings              `json:"settings"`
 	// StaticData   interface{}           `json:"staticData"` // TODO understand how this parameter is used and make it exportable to the state
+
+	// Extra holds workflow fields not listed above, sent along with them.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// updateWorkflowFields is UpdateWorkflowRequest without its JSON methods.
+type updateWorkflowFields UpdateWorkflowRequest
+
+// MarshalJSON encodes the request fields along with the ones in Extra.
+func (r UpdateWorkflowRequest) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(updateWorkflowFields(r), r.Extra)
 }
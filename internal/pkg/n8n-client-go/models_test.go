@@ -5,6 +5,9 @@ package n8n
 
 import (
 	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -246,3 +249,288 @@ func TestAPIResponseParsing(t *testing.T) {
 		t.Errorf("Credential ID mismatch: got %v", credMap["id"])
 	}
 }
+
+// TestSettingsPreservesUnknownKeys verifies that settings without a field survive a round trip.
+func TestSettingsPreservesUnknownKeys(t *testing.T) {
+	apiResponse := `{
+		"executionTimeout": -1,
+		"timezone": "UTC",
+		"callerPolicy": "workflowsFromSameOwner",
+		"concurrency": {"limit": 2}
+	}`
+
+	var settings Settings
+	if err := json.Unmarshal([]byte(apiResponse), &settings); err != nil {
+		t.Fatalf("Failed to parse settings: %v", err)
+	}
+
+	if settings.GetExecutionTimeout() != -1 || settings.GetTimezone() != "UTC" {
+		t.Errorf("Known settings were not parsed: %+v", settings)
+	}
+	if len(settings.Extra) != 2 {
+		t.Fatalf("Expected 2 extra settings, got %v", settings.Extra)
+	}
+
+	settings.Timezone = Ptr("Europe/Berlin")
+	settings.Extra["timezone"] = json.RawMessage(`"ignored"`)
+
+	jsonData, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatalf("Failed to marshal settings: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(jsonData, &result); err != nil {
+		t.Fatalf("Failed to unmarshal settings: %v", err)
+	}
+
+	if result["callerPolicy"] != "workflowsFromSameOwner" {
+		t.Errorf("callerPolicy was not preserved: %v", result["callerPolicy"])
+	}
+	if concurrency, ok := result["concurrency"].(map[string]interface{}); !ok || concurrency["limit"] != float64(2) {
+		t.Errorf("concurrency was not preserved: %v", result["concurrency"])
+	}
+	if result["timezone"] != "Europe/Berlin" {
+		t.Errorf("Known settings should take precedence over extra ones, got timezone %v", result["timezone"])
+	}
+	if result["executionTimeout"] != float64(-1) {
+		t.Errorf("executionTimeout mismatch: %v", result["executionTimeout"])
+	}
+}
+
+// TestSettingsWithoutUnknownKeys verifies that Extra stays nil when all settings are known.
+func TestSettingsWithoutUnknownKeys(t *testing.T) {
+	var settings Settings
+	if err := json.Unmarshal([]byte(`{"timezone": "UTC"}`), &settings); err != nil {
+		t.Fatalf("Failed to parse settings: %v", err)
+	}
+	if settings.Extra != nil {
+		t.Errorf("Expected no extra settings, got %v", settings.Extra)
+	}
+}
+
+// TestSettingsOmitsUnsetFields verifies that settings which are not set are
+// left out, while zero values which are set are sent.
+func TestSettingsOmitsUnsetFields(t *testing.T) {
+	var settings Settings
+	if err := json.Unmarshal([]byte(`{"saveManualExecutions": false, "errorWorkflow": ""}`), &settings); err != nil {
+		t.Fatalf("Failed to parse settings: %v", err)
+	}
+	if settings.SaveManualExecutions == nil || settings.ErrorWorkflow == nil {
+		t.Fatalf("Settings set to zero values should not be nil: %+v", settings)
+	}
+	if settings.Timezone != nil || settings.GetTimezone() != "" {
+		t.Errorf("Missing timezone should be unset: %v", settings.Timezone)
+	}
+
+	settings.ExecutionTimeout = Ptr(0)
+	jsonData, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatalf("Failed to marshal settings: %v", err)
+	}
+	if string(jsonData) != `{"saveManualExecutions":false,"executionTimeout":0,"errorWorkflow":""}` {
+		t.Errorf("Unexpected settings JSON: %s", jsonData)
+	}
+}
+
+// TestNodeDisabledRoundTrip verifies that the disabled flag is sent only when set.
+func TestNodeDisabledRoundTrip(t *testing.T) {
+	var node Node
+	if err := json.Unmarshal([]byte(`{"name": "Wait", "disabled": true}`), &node); err != nil {
+		t.Fatalf("Failed to parse node: %v", err)
+	}
+	if !node.Disabled {
+		t.Error("disabled was not parsed")
+	}
+
+	jsonData, err := json.Marshal(Node{Name: "Wait"})
+	if err != nil {
+		t.Fatalf("Failed to marshal node: %v", err)
+	}
+	if strings.Contains(string(jsonData), "disabled") {
+		t.Errorf("Enabled nodes should not include disabled: %s", jsonData)
+	}
+}
+
+// TestNodeNotesRoundTrip verifies that node notes survive JSON marshaling/unmarshaling.
+func TestNodeNotesRoundTrip(t *testing.T) {
+	var node Node
+	if err := json.Unmarshal([]byte(`{"name": "Wait", "notes": "Gives the API time to settle", "notesInFlow": true}`), &node); err != nil {
+		t.Fatalf("Failed to parse node: %v", err)
+	}
+	if node.Notes != "Gives the API time to settle" || !node.NotesInFlow {
+		t.Errorf("Notes were not parsed: %+v", node)
+	}
+
+	jsonData, err := json.Marshal(node)
+	if err != nil {
+		t.Fatalf("Failed to marshal node: %v", err)
+	}
+	if !strings.Contains(string(jsonData), `"notes":"Gives the API time to settle","notesInFlow":true`) {
+		t.Errorf("Notes were not marshaled: %s", jsonData)
+	}
+}
+
+// TestWorkflowPreservesUnknownFields verifies that workflow fields without a
+// struct field are kept and can be sent back in an update.
+func TestWorkflowPreservesUnknownFields(t *testing.T) {
+	apiResponse := `{
+		"id": "wf-1",
+		"name": "Sync",
+		"nodes": [],
+		"connections": {},
+		"settings": {"timezone": "UTC"},
+		"description": "Nightly sync",
+		"meta": {"templateId": "42"}
+	}`
+
+	var workflow Workflow
+	if err := json.Unmarshal([]byte(apiResponse), &workflow); err != nil {
+		t.Fatalf("Failed to parse workflow: %v", err)
+	}
+	if workflow.ID != "wf-1" || workflow.Settings.GetTimezone() != "UTC" {
+		t.Errorf("Known fields were not parsed: %+v", workflow)
+	}
+	if len(workflow.Extra) != 2 || string(workflow.Extra["description"]) != `"Nightly sync"` {
+		t.Fatalf("Expected 2 extra fields, got %v", workflow.Extra)
+	}
+
+	jsonData, err := json.Marshal(UpdateWorkflowRequest{
+		Name:  workflow.Name,
+		Extra: map[string]json.RawMessage{"description": workflow.Extra["description"], "name": json.RawMessage(`"ignored"`)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(jsonData, &result); err != nil {
+		t.Fatalf("Failed to unmarshal request: %v", err)
+	}
+	if result["description"] != "Nightly sync" {
+		t.Errorf("description was not sent: %s", jsonData)
+	}
+	if result["name"] != "Sync" {
+		t.Errorf("Known fields should take precedence over extra ones, got name %v", result["name"])
+	}
+}
+
+// TestWorkflowRequestWithoutExtraFields verifies that requests without extra
+// fields only encode the known ones.
+func TestWorkflowRequestWithoutExtraFields(t *testing.T) {
+	jsonData, err := json.Marshal(CreateWorkflowRequest{Name: "Sync"})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(jsonData, &result); err != nil {
+		t.Fatalf("Failed to unmarshal request: %v", err)
+	}
+	if len(result) != 4 {
+		t.Errorf("Expected the 4 known fields, got %s", jsonData)
+	}
+}
+
+// TestWorkflowContentRoundTrip verifies that node fields without a struct
+// field and connections of every type are sent back unchanged in an update.
+func TestWorkflowContentRoundTrip(t *testing.T) {
+	nodes := `[{
+		"id": "1",
+		"name": "Webhook",
+		"type": "n8n-nodes-base.webhook",
+		"typeVersion": 2,
+		"position": [0, 0],
+		"parameters": {"path": "orders"},
+		"webhookId": "5f6d0c2e-1b7a-4a55-9a52-0a8e3e0f2b11",
+		"onError": "continueErrorOutput",
+		"retryOnFail": true,
+		"maxTries": 5,
+		"waitBetweenTries": 2000,
+		"executeOnce": true,
+		"alwaysOutputData": true
+	}]`
+	connections := `{
+		"Webhook": {"main": [[{"node": "Agent", "type": "main", "index": 0}]]},
+		"Calculator": {"ai_tool": [[{"node": "Agent", "type": "ai_tool", "index": 0}]]},
+		"Model": {"ai_languageModel": [[{"node": "Agent", "type": "ai_languageModel", "index": 0}]]}
+	}`
+
+	var workflow Workflow
+	if err := json.Unmarshal([]byte(`{"name": "Agent", "nodes": `+nodes+`, "connections": `+connections+`}`), &workflow); err != nil {
+		t.Fatalf("Failed to parse workflow: %v", err)
+	}
+	if workflow.Nodes[0].Name != "Webhook" || len(workflow.Nodes[0].Extra) != 7 {
+		t.Errorf("Expected the known node fields and 7 extra ones, got %+v", workflow.Nodes[0])
+	}
+
+	jsonData, err := json.Marshal(UpdateWorkflowRequest{Name: workflow.Name, Nodes: workflow.Nodes, Connections: workflow.Connections})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+
+	var sent, expected struct {
+		Nodes       []map[string]interface{} `json:"nodes"`
+		Connections map[string]interface{}   `json:"connections"`
+	}
+	if err := json.Unmarshal(jsonData, &sent); err != nil {
+		t.Fatalf("Failed to unmarshal request: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"nodes": `+nodes+`, "connections": `+connections+`}`), &expected); err != nil {
+		t.Fatalf("Failed to unmarshal expected content: %v", err)
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("Content was not sent back unchanged:\n got %s", jsonData)
+	}
+}
+
+// TestWorkflowWritableExtra verifies that only the fields accepted by the
+// update endpoint are sent back from a workflow read from the API.
+func TestWorkflowWritableExtra(t *testing.T) {
+	apiResponse := `{
+		"id": "wf-1",
+		"name": "Sync",
+		"active": true,
+		"nodes": [],
+		"connections": {},
+		"settings": {},
+		"staticData": {"lastId": 42},
+		"pinData": {},
+		"meta": {"templateId": "42"},
+		"shared": [{"role": "workflow:owner"}],
+		"isArchived": false
+	}`
+
+	var workflow Workflow
+	if err := json.Unmarshal([]byte(apiResponse), &workflow); err != nil {
+		t.Fatalf("Failed to parse workflow: %v", err)
+	}
+
+	jsonData, err := json.Marshal(UpdateWorkflowRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+		Extra:       workflow.WritableExtra(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(jsonData, &result); err != nil {
+		t.Fatalf("Failed to unmarshal request: %v", err)
+	}
+	var keys []string
+	for key := range result {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if expected := []string{"connections", "name", "nodes", "pinData", "settings", "staticData"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected the keys %v, got %v", expected, keys)
+	}
+
+	if extra := (&Workflow{Extra: map[string]json.RawMessage{"shared": json.RawMessage(`[]`)}}).WritableExtra(); extra != nil {
+		t.Errorf("Expected no writable fields, got %v", extra)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// maxPageSize is the largest number of items the API returns in a page.
+const maxPageSize = 250
+
+// ListOption configures a call listing workflows, tags or variables. Without
+// options, list calls follow the pagination cursor until every item is
+// retrieved.
+type ListOption func(*listOptions)
+
+// listOptions holds the query parameters and the item limit of a list call.
+type listOptions struct {
+	query    url.Values
+	limit    int
+	pageSize int
+}
+
+// WithLimit returns at most limit items. The cursor of the next item is then
+// returned in NextCursor, to be passed to WithCursor to continue the listing.
+func WithLimit(limit int) ListOption {
+	return func(o *listOptions) {
+		o.limit = limit
+	}
+}
+
+// WithCursor starts the listing at the cursor returned by a previous call.
+func WithCursor(cursor string) ListOption {
+	return withQuery("cursor", cursor)
+}
+
+// WithTag only lists the workflows with the given tag name. Several tags can
+// be given with one option each. Only applies to workflow lists.
+func WithTag(name string) ListOption {
+	return func(o *listOptions) {
+		if tags := o.query.Get("tags"); tags != "" {
+			name = tags + "," + name
+		}
+		o.query.Set("tags", name)
+	}
+}
+
+// WithActive only lists the active or the inactive workflows. Only applies to
+// workflow lists.
+func WithActive(active bool) ListOption {
+	return withQuery("active", strconv.FormatBool(active))
+}
+
+// withQuery sets a query parameter of a list call.
+func withQuery(key, value string) ListOption {
+	return func(o *listOptions) {
+		o.query.Set(key, value)
+	}
+}
+
+// newListOptions applies opts to the options of a list call. A non-zero
+// pageSize is requested for every page unless a smaller limit is set.
+func newListOptions(pageSize int, opts []ListOption) *listOptions {
+	o := &listOptions{query: url.Values{}, pageSize: pageSize}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// pageURL returns the URL of the next page of endpoint once collected items
+// are retrieved.
+func (o *listOptions) pageURL(endpoint string, collected int) string {
+	query := url.Values{}
+	for key, values := range o.query {
+		query[key] = values
+	}
+
+	pageSize := o.pageSize
+	if o.limit > 0 {
+		pageSize = min(o.limit-collected, maxPageSize)
+	}
+	if pageSize > 0 {
+		query.Set("limit", strconv.Itoa(pageSize))
+	}
+
+	if len(query) == 0 {
+		return endpoint
+	}
+	return fmt.Sprintf("%s?%s", endpoint, query.Encode())
+}
+
+// next records the cursor of the page following collected items, and reports
+// whether that page should be requested.
+func (o *listOptions) next(cursor *string, collected int) bool {
+	if cursor == nil || (o.limit > 0 && collected >= o.limit) {
+		return false
+	}
+	o.query.Set("cursor", *cursor)
+	return true
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWorkflowsWithFilters(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "production,billing", query.Get("tags"))
+		require.Equal(t, "false", query.Get("active"))
+		require.Empty(t, query.Get("limit"), "without a limit, the API picks the page size")
+		_, _ = w.Write([]byte(`{"data": [{"id": "1", "name": "Invoices"}], "nextCursor": null}`))
+	})
+
+	workflows, err := client.GetWorkflows(WithTag("production"), WithTag("billing"), WithActive(false))
+	require.NoError(t, err)
+	require.Len(t, workflows.Data, 1)
+}
+
+func TestGetWorkflowsWithLimit(t *testing.T) {
+	var limits, cursors []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		cursors = append(cursors, r.URL.Query().Get("cursor"))
+		if r.URL.Query().Get("cursor") == "start" {
+			_, _ = w.Write([]byte(`{"data": [{"id": "1"}, {"id": "2"}], "nextCursor": "second"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": [{"id": "3"}], "nextCursor": "third"}`))
+	})
+
+	workflows, err := client.GetWorkflows(WithCursor("start"), WithLimit(3))
+	require.NoError(t, err)
+	require.Len(t, workflows.Data, 3)
+	require.Equal(t, []string{"3", "1"}, limits, "pages never go past the limit")
+	require.Equal(t, []string{"start", "second"}, cursors)
+	require.NotNil(t, workflows.NextCursor)
+	require.Equal(t, "third", *workflows.NextCursor, "the cursor continues the listing")
+}
+
+func TestGetVariablesPageSize(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "250", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	})
+
+	_, err := client.GetVariables()
+	require.NoError(t, err)
+}
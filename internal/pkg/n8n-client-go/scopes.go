@@ -0,0 +1,113 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// scopeResources maps the collections of the public API to the resource name
+// used in the scopes of API keys, e.g. workflow:read.
+var scopeResources = map[string]string{
+	"credentials": "credential",
+	"executions":  "execution",
+	"projects":    "project",
+	"tags":        "tag",
+	"users":       "user",
+	"variables":   "variable",
+	"workflows":   "workflow",
+}
+
+// requiredScope returns the API key scope needed to send a request with the
+// given method to the given path, or an empty string if it is not known.
+func requiredScope(method, path string) string {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, "/api/v1"), "/"), "/")
+	resource, ok := scopeResources[segments[0]]
+	if !ok {
+		return ""
+	}
+
+	switch len(segments) {
+	case 1:
+		switch method {
+		case http.MethodGet:
+			return resource + ":list"
+		case http.MethodPost:
+			return resource + ":create"
+		}
+	case 2:
+		switch method {
+		case http.MethodGet:
+			return resource + ":read"
+		case http.MethodPut, http.MethodPatch:
+			return resource + ":update"
+		case http.MethodDelete:
+			return resource + ":delete"
+		}
+	case 3:
+		switch {
+		case resource == "workflow" && segments[2] == "tags" && method == http.MethodGet:
+			return "workflowTags:list"
+		case resource == "workflow" && segments[2] == "tags" && method == http.MethodPut:
+			return "workflowTags:update"
+		case resource == "workflow" && method == http.MethodPost:
+			// activate and deactivate
+			return resource + ":" + segments[2]
+		}
+	}
+	return ""
+}
+
+// MissingScope returns the API key scope that a request failing with err
+// required, if the request was forbidden and the scope is known.
+func MissingScope(err error) (string, bool) {
+	var status *statusError
+	if !errors.As(err, &status) || status.statusCode != http.StatusForbidden || status.scope == "" {
+		return "", false
+	}
+	return status.scope, true
+}
+
+// probedScopes lists the scopes ProbeScopes checks, with the path of a
+// request that only requires that scope and has no side effect.
+var probedScopes = []struct {
+	scope string
+	path  string
+}{
+	{"workflow:list", "/api/v1/workflows?limit=1"},
+	{"tag:list", "/api/v1/tags?limit=1"},
+	{"execution:list", "/api/v1/executions?limit=1"},
+}
+
+// ProbeScopes checks which of the scopes that can be tested without side
+// effects are granted to the API key of the client, by sending a request
+// requiring each of them. The public API has no endpoint listing the scopes
+// of a key, so scopes needed to write cannot be detected.
+//
+// Returns the probed scopes with whether they are granted, or an error if a
+// request fails for another reason than a missing scope.
+func (c *Client) ProbeScopes() (map[string]bool, error) {
+	granted := make(map[string]bool, len(probedScopes))
+	for _, probe := range probedScopes {
+		req, err := http.NewRequest("GET", c.HostURL+probe.path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = c.doRequest(req)
+		var status *statusError
+		switch {
+		case err == nil:
+			granted[probe.scope] = true
+		case errors.As(err, &status) && status.statusCode == http.StatusForbidden:
+			granted[probe.scope] = false
+		default:
+			return nil, fmt.Errorf("probing scope %s: %w", probe.scope, err)
+		}
+	}
+	return granted, nil
+}
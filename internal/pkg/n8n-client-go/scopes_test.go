@@ -0,0 +1,88 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequiredScope(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		scope  string
+	}{
+		{http.MethodGet, "/api/v1/workflows", "workflow:list"},
+		{http.MethodPost, "/api/v1/workflows", "workflow:create"},
+		{http.MethodGet, "/api/v1/workflows/1", "workflow:read"},
+		{http.MethodPut, "/api/v1/workflows/1", "workflow:update"},
+		{http.MethodDelete, "/api/v1/workflows/1", "workflow:delete"},
+		{http.MethodPost, "/api/v1/workflows/1/activate", "workflow:activate"},
+		{http.MethodPost, "/api/v1/workflows/1/deactivate", "workflow:deactivate"},
+		{http.MethodGet, "/api/v1/workflows/1/tags", "workflowTags:list"},
+		{http.MethodPut, "/api/v1/workflows/1/tags", "workflowTags:update"},
+		{http.MethodPut, "/api/v1/variables/1", "variable:update"},
+		{http.MethodPost, "/api/v1/credentials", "credential:create"},
+		{http.MethodGet, "/api/v1/unknown", ""},
+		{http.MethodGet, "/webhook/orders", ""},
+	}
+
+	for _, tt := range tests {
+		if scope := requiredScope(tt.method, tt.path); scope != tt.scope {
+			t.Errorf("%s %s: expected scope %q, got %q", tt.method, tt.path, tt.scope, scope)
+		}
+	}
+}
+
+func TestMissingScope(t *testing.T) {
+	status := http.StatusForbidden
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(`{"message":"Forbidden"}`))}, nil
+	})
+
+	_, err := client.DeleteWorkflow("1")
+	scope, ok := MissingScope(err)
+	if !ok || scope != "workflow:delete" {
+		t.Fatalf("expected missing scope workflow:delete, got %q (%v)", scope, err)
+	}
+	if !strings.Contains(err.Error(), "missing the workflow:delete scope") {
+		t.Errorf("expected the error to name the scope, got: %v", err)
+	}
+
+	status = http.StatusNotFound
+	_, err = client.DeleteWorkflow("1")
+	if _, ok := MissingScope(err); ok {
+		t.Errorf("expected no missing scope for a 404 error")
+	}
+}
+
+func TestProbeScopes(t *testing.T) {
+	status := map[string]int{
+		"/api/v1/workflows":  http.StatusOK,
+		"/api/v1/tags":       http.StatusForbidden,
+		"/api/v1/executions": http.StatusOK,
+	}
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: status[req.URL.Path], Body: io.NopCloser(strings.NewReader(`{"data":[]}`))}, nil
+	})
+
+	scopes, err := client.ProbeScopes()
+	if err != nil {
+		t.Fatalf("ProbeScopes returned an error: %v", err)
+	}
+	expected := map[string]bool{"workflow:list": true, "tag:list": false, "execution:list": true}
+	for scope, granted := range expected {
+		if scopes[scope] != granted {
+			t.Errorf("expected %s granted=%t, got %t", scope, granted, scopes[scope])
+		}
+	}
+
+	status["/api/v1/tags"] = http.StatusUnauthorized
+	if _, err := client.ProbeScopes(); err == nil {
+		t.Errorf("expected an invalid API key to fail the probe")
+	}
+}
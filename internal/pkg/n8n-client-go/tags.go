@@ -0,0 +1,315 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// tagCache remembers the IDs of tags by name. It is safe for concurrent use.
+type tagCache struct {
+	mu  sync.Mutex
+	ids map[string]string
+}
+
+// set records the ID of a tag. It does nothing on a nil receiver.
+func (t *tagCache) set(name, id string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.store(name, id)
+}
+
+// store records the ID of a tag. The lock must be held.
+func (t *tagCache) store(name, id string) {
+	t.forget(id)
+	if t.ids == nil {
+		t.ids = make(map[string]string)
+	}
+	t.ids[name] = id
+}
+
+// remove forgets the tag with the given ID. It does nothing on a nil
+// receiver.
+func (t *tagCache) remove(id string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.forget(id)
+}
+
+// forget removes the names of the tag with the given ID. The lock must be
+// held.
+func (t *tagCache) forget(id string) {
+	for name, cached := range t.ids {
+		if cached == id {
+			delete(t.ids, name)
+		}
+	}
+}
+
+// ResolveTagIDs returns the IDs of the tags with the given names, keyed by
+// name. Names without a tag are left out; use ResolveOrCreateTagID to create
+// a missing tag without racing concurrent callers.
+//
+// Tags are listed in one call and cached by the client, and listed again only
+// when a name is missing from the cache, so that resolving the same tags for
+// many workflows does not look them up each time. Concurrent calls wait for
+// the listing in progress rather than listing the tags themselves.
+func (c *Client) ResolveTagIDs(names []string) (map[string]string, error) {
+	cache := c.cachedTags()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return c.resolveTagIDs(cache, names)
+}
+
+// ResolveOrCreateTagID returns the ID of the tag with the given name, creating
+// the tag when it does not exist.
+//
+// The lookup and the creation hold the lock of the tag cache, so concurrent
+// calls for the same name create the tag once. A tag created by another
+// client since the tags were listed, which n8n reports with a conflict, is
+// looked up again instead of failing.
+func (c *Client) ResolveOrCreateTagID(name string) (string, error) {
+	cache := c.cachedTags()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	ids, err := c.resolveTagIDs(cache, []string{name})
+	if err != nil {
+		return "", err
+	}
+	if id, ok := ids[name]; ok {
+		return id, nil
+	}
+
+	tag, err := c.createTag(&CreateTagRequest{Name: name})
+	var status *statusError
+	if errors.As(err, &status) && status.statusCode == http.StatusConflict {
+		ids, listErr := c.resolveTagIDs(cache, []string{name})
+		if listErr != nil {
+			return "", listErr
+		}
+		if id, ok := ids[name]; ok {
+			return id, nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	cache.store(tag.Name, tag.ID)
+
+	return tag.ID, nil
+}
+
+// cachedTags returns the tag cache of the client, or a new one for clients
+// without a cache.
+func (c *Client) cachedTags() *tagCache {
+	if c.tags == nil {
+		return &tagCache{}
+	}
+	return c.tags
+}
+
+// resolveTagIDs implements ResolveTagIDs. The lock of cache must be held.
+func (c *Client) resolveTagIDs(cache *tagCache, names []string) (map[string]string, error) {
+	resolved := make(map[string]string, len(names))
+	missing := false
+	for _, name := range names {
+		if id, ok := cache.ids[name]; ok {
+			resolved[name] = id
+		} else {
+			missing = true
+		}
+	}
+	if !missing {
+		return resolved, nil
+	}
+
+	tags, err := c.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	cache.ids = make(map[string]string, len(tags.Data))
+	for _, tag := range tags.Data {
+		cache.ids[tag.Name] = tag.ID
+	}
+
+	for _, name := range names {
+		if id, ok := cache.ids[name]; ok {
+			resolved[name] = id
+		}
+	}
+	return resolved, nil
+}
+
+// GetTags retrieves all tags from your n8n instance.
+// This method supports pagination and will automatically iterate through
+// all available pages by following the cursor in the response. Options
+// bound the number retrieved.
+//
+// Returns a pointer to a TagsResponse containing all tags,
+// or an error if the request or response decoding fails.
+func (c *Client) GetTags(opts ...ListOption) (*TagsResponse, error) {
+	var allTags TagsResponse
+	options := newListOptions(0, opts)
+
+	for {
+		var tags TagsResponse
+		if err := c.getPage(options.pageURL(fmt.Sprintf("%s/api/v1/tags", c.HostURL), len(allTags.Data)), &tags); err != nil {
+			return nil, err
+		}
+
+		allTags.Data = append(allTags.Data, tags.Data...)
+		allTags.NextCursor = tags.NextCursor
+		if !options.next(tags.NextCursor, len(allTags.Data)) {
+			break
+		}
+	}
+
+	return &allTags, nil
+}
+
+// CreateTag creates a new tag in n8n.
+//
+// Parameters:
+//   - createTagRequest: the tag data to be created.
+//
+// Returns the created Tag object or an error if the request or decoding fails.
+func (c *Client) CreateTag(createTagRequest *CreateTagRequest) (*Tag, error) {
+	tag, err := c.createTag(createTagRequest)
+	if err != nil {
+		return nil, err
+	}
+	c.tags.set(tag.Name, tag.ID)
+
+	return tag, nil
+}
+
+// createTag implements CreateTag without caching the created tag.
+func (c *Client) createTag(createTagRequest *CreateTagRequest) (*Tag, error) {
+	payload, err := json.Marshal(createTagRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tag: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/tags", c.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	tag := &Tag{}
+	if err := c.doJSONRequest(req, tag); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	return tag, nil
+}
+
+// GetTag retrieves a tag by its ID.
+//
+// Parameters:
+//   - tagID: the unique identifier of the tag.
+//
+// Returns the Tag object, or an error if the request or decoding fails.
+func (c *Client) GetTag(tagID string) (*Tag, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/tags/%s", c.HostURL, tagID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &Tag{}
+	if err := c.doJSONRequest(req, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// UpdateTag renames a tag.
+//
+// Parameters:
+//   - tagID: the unique identifier of the tag.
+//   - updateTagRequest: the new tag data.
+//
+// Returns the updated Tag object, or an error if the request or decoding fails.
+func (c *Client) UpdateTag(tagID string, updateTagRequest *UpdateTagRequest) (*Tag, error) {
+	payload, err := json.Marshal(updateTagRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tag: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/tags/%s", c.HostURL, tagID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	tag := &Tag{}
+	if err := c.doJSONRequest(req, tag); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	c.tags.set(tag.Name, tag.ID)
+
+	return tag, nil
+}
+
+// DeleteTag deletes a tag by its ID, removing it from the workflows using it.
+//
+// Parameters:
+//   - tagID: the unique identifier of the tag to delete.
+//
+// Returns the deleted Tag object, or an error if the request or decoding fails.
+func (c *Client) DeleteTag(tagID string) (*Tag, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/tags/%s", c.HostURL, tagID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := &Tag{}
+	if err := c.doJSONRequest(req, tag); err != nil {
+		return nil, err
+	}
+	c.tags.remove(tagID)
+
+	return tag, nil
+}
+
+// UpdateWorkflowTags replaces the tags of a workflow.
+//
+// Parameters:
+//   - workflowID: the unique identifier of the workflow.
+//   - tags: the tags to assign to the workflow.
+//
+// Returns the tags of the workflow after the update, or an error if the request or decoding fails.
+func (c *Client) UpdateWorkflowTags(workflowID string, tags []TagReference) ([]Tag, error) {
+	payload, err := json.Marshal(tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tags: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/workflows/%s/tags", c.HostURL, workflowID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var updated []Tag
+	if err := c.doJSONRequest(req, &updated); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,245 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+	return client
+}
+
+func TestGetTags(t *testing.T) {
+	mockResponses := []string{
+		`{"data": [{"id": "1", "name": "production"}], "nextCursor": "abc"}`,
+		`{"data": [{"id": "2", "name": "terraform-managed"}], "nextCursor": null}`,
+	}
+	requestCount := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/tags", r.URL.Path)
+		if requestCount == 1 {
+			require.Equal(t, "abc", r.URL.Query().Get("cursor"))
+		}
+		_, _ = w.Write([]byte(mockResponses[requestCount]))
+		requestCount++
+	})
+
+	tags, err := client.GetTags()
+	require.NoError(t, err)
+	require.Len(t, tags.Data, 2)
+	require.Equal(t, "terraform-managed", tags.Data[1].Name)
+}
+
+func TestResolveTagIDs(t *testing.T) {
+	tags := `{"data": [{"id": "1", "name": "production"}, {"id": "2", "name": "billing"}], "nextCursor": null}`
+	listed := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tags":
+			listed++
+			_, _ = w.Write([]byte(tags))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tags":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "3", "name": "terraform-managed"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/tags/1":
+			_, _ = w.Write([]byte(`{"id": "1", "name": "production"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	// The tags are listed once for every resolution of known names
+	for i := 0; i < 50; i++ {
+		ids, err := client.ResolveTagIDs([]string{"production", "billing"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"production": "1", "billing": "2"}, ids)
+	}
+	require.Equal(t, 1, listed)
+
+	// Created tags are cached too
+	_, err := client.CreateTag(&CreateTagRequest{Name: "terraform-managed"})
+	require.NoError(t, err)
+	ids, err := client.ResolveTagIDs([]string{"terraform-managed", "billing"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"terraform-managed": "3", "billing": "2"}, ids)
+	require.Equal(t, 1, listed)
+
+	// Unknown names list the tags again, deleted tags are forgotten
+	_, err = client.DeleteTag("1")
+	require.NoError(t, err)
+	tags = `{"data": [{"id": "2", "name": "billing"}], "nextCursor": null}`
+	ids, err = client.ResolveTagIDs([]string{"production", "billing"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"billing": "2"}, ids)
+	require.Equal(t, 2, listed)
+}
+
+func TestResolveOrCreateTagID(t *testing.T) {
+	var mu sync.Mutex
+	var tags []Tag
+	var createdElsewhere *Tag
+	created := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tags":
+			require.NoError(t, json.NewEncoder(w).Encode(TagsResponse{Data: tags}))
+			if createdElsewhere != nil {
+				tags = append(tags, *createdElsewhere)
+				createdElsewhere = nil
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tags":
+			var body CreateTagRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			for _, tag := range tags {
+				if tag.Name == body.Name {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"message": "Tag already exists"}`))
+					return
+				}
+			}
+			created++
+			tag := Tag{ID: fmt.Sprint(len(tags) + 1), Name: body.Name}
+			tags = append(tags, tag)
+			w.WriteHeader(http.StatusCreated)
+			require.NoError(t, json.NewEncoder(w).Encode(tag))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	// Concurrent callers create the tag once
+	var wg sync.WaitGroup
+	ids := make([]string, 10)
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := client.ResolveOrCreateTagID("terraform-managed")
+			assert.NoError(t, err)
+			ids[i] = id
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, created)
+	for _, id := range ids {
+		require.Equal(t, "1", id)
+	}
+
+	// A tag created by another client since the tags were listed
+	mu.Lock()
+	createdElsewhere = &Tag{ID: "2", Name: "billing"}
+	mu.Unlock()
+	id, err := client.ResolveOrCreateTagID("billing")
+	require.NoError(t, err)
+	require.Equal(t, "2", id)
+	require.Equal(t, 1, created)
+}
+
+func TestCreateTag(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/tags", r.URL.Path)
+
+		var body CreateTagRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "terraform-managed", body.Name)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "2", "name": "terraform-managed"}`))
+	})
+
+	tag, err := client.CreateTag(&CreateTagRequest{Name: "terraform-managed"})
+	require.NoError(t, err)
+	require.Equal(t, "2", tag.ID)
+}
+
+func TestGetTag(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/tags/2", r.URL.Path)
+		_, _ = w.Write([]byte(`{"id": "2", "name": "terraform-managed"}`))
+	})
+
+	tag, err := client.GetTag("2")
+	require.NoError(t, err)
+	require.Equal(t, "terraform-managed", tag.Name)
+}
+
+func TestUpdateTag(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/tags/2", r.URL.Path)
+
+		var body UpdateTagRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "platform", body.Name)
+
+		_, _ = w.Write([]byte(`{"id": "2", "name": "platform"}`))
+	})
+
+	tag, err := client.UpdateTag("2", &UpdateTagRequest{Name: "platform"})
+	require.NoError(t, err)
+	require.Equal(t, "platform", tag.Name)
+}
+
+func TestDeleteTag(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/api/v1/tags/2", r.URL.Path)
+		_, _ = w.Write([]byte(`{"id": "2", "name": "platform"}`))
+	})
+
+	tag, err := client.DeleteTag("2")
+	require.NoError(t, err)
+	require.Equal(t, "2", tag.ID)
+}
+
+func TestUpdateWorkflowTags(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/workflows/wf1/tags", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"id": "1"}, {"id": "2"}]`, string(body))
+
+		_, _ = w.Write([]byte(`[{"id": "1", "name": "production"}, {"id": "2", "name": "terraform-managed"}]`))
+	})
+
+	tags, err := client.UpdateWorkflowTags("wf1", []TagReference{{ID: "1"}, {ID: "2"}})
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+}
+
+func TestUpdateWorkflowTags_Error(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	_, err := client.UpdateWorkflowTags("missing", []TagReference{{ID: "1"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DefaultTemplatesHostURL is the URL of the public n8n template library API.
+const DefaultTemplatesHostURL = "https://api.n8n.io"
+
+// GetWorkflowTemplate retrieves a workflow template from the n8n template library.
+// The template library is a public service separate from the n8n instance, so
+// the request is sent without the instance API key.
+//
+// Parameters:
+//   - templatesHostURL: the URL of the template library API, usually DefaultTemplatesHostURL.
+//   - templateID: the identifier of the template.
+//
+// Returns the WorkflowTemplate, or an error if the request or decoding fails.
+func (c *Client) GetWorkflowTemplate(templatesHostURL string, templateID int64) (*WorkflowTemplate, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/templates/workflows/%d", templatesHostURL, templateID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	var response WorkflowTemplateResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Workflow, nil
+}
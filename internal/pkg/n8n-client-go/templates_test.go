@@ -0,0 +1,41 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWorkflowTemplate(t *testing.T) {
+	templates := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/templates/workflows/1750", r.URL.Path)
+		require.Empty(t, r.Header.Get("X-N8N-API-KEY"), "the instance API key must not be sent to the template library")
+
+		_, _ = w.Write([]byte(`{"workflow": {"id": 1750, "name": "Slack alerts", "description": "Posts alerts", "workflow": {"nodes": [{"name": "Slack", "type": "n8n-nodes-base.slack"}], "connections": {}}}}`))
+	})
+
+	token := "instance-token"
+	host := "https://n8n.example.com"
+	client, err := NewClient(&host, &token)
+	require.NoError(t, err)
+
+	template, err := client.GetWorkflowTemplate(templates.HostURL, 1750)
+	require.NoError(t, err)
+	require.Equal(t, int64(1750), template.ID)
+	require.Equal(t, "Slack alerts", template.Name)
+	require.Len(t, template.Workflow.Nodes, 1)
+}
+
+func TestGetWorkflowTemplate_NotFound(t *testing.T) {
+	templates := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := templates.GetWorkflowTemplate(templates.HostURL, 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts a span around an API call when the client has a Tracer.
+// It returns the request carrying the span, and a function ending the span
+// with the outcome of the call.
+func (c *Client) startSpan(req *http.Request) (*http.Request, func(res *http.Response, err error)) {
+	if c.Tracer == nil {
+		return req, func(*http.Response, error) {}
+	}
+
+	// Spans without a valid parent start a new trace
+	ctx := trace.ContextWithRemoteSpanContext(req.Context(), c.TraceParent)
+	ctx, span := c.Tracer.Start(ctx, "n8n "+req.Method+" "+req.URL.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Host),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+
+	return req.WithContext(ctx), func(res *http.Response, err error) {
+		defer span.End()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return
+		}
+		span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+		if res.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, res.Status)
+		}
+	}
+}
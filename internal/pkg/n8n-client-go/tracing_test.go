@@ -0,0 +1,67 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if !trace.SpanContextFromContext(req.Context()).IsValid() {
+			t.Errorf("expected the request to carry the span")
+		}
+		return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader("not found"))}, nil
+	})
+	client.Tracer = tracerProvider.Tracer("test")
+	client.TraceParent = parent
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/api/v1/workflows/1", nil)
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatalf("expected error due to non-200 status code")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "n8n GET /api/v1/workflows/1" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanID() || span.SpanContext().TraceID() != parent.TraceID() {
+		t.Errorf("expected the span to be a child of the trace parent")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected an error status, got %v", span.Status())
+	}
+	found := false
+	for _, attr := range span.Attributes() {
+		if attr == attribute.Int("http.response.status_code", http.StatusNotFound) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the status code attribute, got %v", span.Attributes())
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetVariables retrieves all variables from your n8n instance.
+// This method supports pagination and will automatically iterate through
+// all available pages by following the cursor in the response. Options
+// bound the number retrieved.
+//
+// Returns a pointer to a VariablesResponse containing all variables,
+// or an error if the request or response decoding fails.
+func (c *Client) GetVariables(opts ...ListOption) (*VariablesResponse, error) {
+	var allVariables VariablesResponse
+	options := newListOptions(250, opts)
+
+	for {
+		var variables VariablesResponse
+		if err := c.getPage(options.pageURL(fmt.Sprintf("%s/api/v1/variables", c.HostURL), len(allVariables.Data)), &variables); err != nil {
+			return nil, err
+		}
+
+		allVariables.Data = append(allVariables.Data, variables.Data...)
+		allVariables.NextCursor = variables.NextCursor
+		if !options.next(variables.NextCursor, len(allVariables.Data)) {
+			break
+		}
+	}
+
+	return &allVariables, nil
+}
+
+// CreateVariable creates a new variable in n8n. The API does not return the
+// created variable.
+//
+// Parameters:
+//   - createVariableRequest: the variable data to be created.
+//
+// Returns an error if the request fails.
+func (c *Client) CreateVariable(createVariableRequest *CreateVariableRequest) error {
+	payload, err := json.Marshal(createVariableRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal variable: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/variables", c.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateVariable replaces the key and value of a variable.
+//
+// Parameters:
+//   - variableID: the unique identifier of the variable.
+//   - updateVariableRequest: the new variable data.
+//
+// Returns an error if the request fails.
+func (c *Client) UpdateVariable(variableID string, updateVariableRequest *UpdateVariableRequest) error {
+	payload, err := json.Marshal(updateVariableRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal variable: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/variables/%s", c.HostURL, variableID), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteVariable deletes a variable by its ID.
+//
+// Parameters:
+//   - variableID: the unique identifier of the variable to delete.
+//
+// Returns an error if the request fails.
+func (c *Client) DeleteVariable(variableID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/variables/%s", c.HostURL, variableID), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
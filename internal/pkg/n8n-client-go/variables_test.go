@@ -0,0 +1,75 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVariables(t *testing.T) {
+	mockResponses := []string{
+		`{"data": [{"id": "1", "key": "REGION", "value": "eu", "type": "string"}], "nextCursor": "abc"}`,
+		`{"data": [{"id": "2", "key": "TIER", "value": "prod", "type": "string"}], "nextCursor": null}`,
+	}
+	requestCount := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/variables", r.URL.Path)
+		require.Equal(t, "250", r.URL.Query().Get("limit"))
+		if requestCount == 1 {
+			require.Equal(t, "abc", r.URL.Query().Get("cursor"))
+		}
+		_, _ = w.Write([]byte(mockResponses[requestCount]))
+		requestCount++
+	})
+
+	variables, err := client.GetVariables()
+	require.NoError(t, err)
+	require.Len(t, variables.Data, 2)
+	require.Equal(t, "TIER", variables.Data[1].Key)
+}
+
+func TestCreateVariable(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/variables", r.URL.Path)
+
+		var body CreateVariableRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, CreateVariableRequest{Key: "REGION", Value: "eu"}, body)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	require.NoError(t, client.CreateVariable(&CreateVariableRequest{Key: "REGION", Value: "eu"}))
+}
+
+func TestUpdateVariable(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/variables/1", r.URL.Path)
+
+		var body UpdateVariableRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "us", body.Value)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	require.NoError(t, client.UpdateVariable("1", &UpdateVariableRequest{Key: "REGION", Value: "us"}))
+}
+
+func TestDeleteVariable(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/api/v1/variables/1", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	require.NoError(t, client.DeleteVariable("1"))
+}
@@ -0,0 +1,34 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// GetVersion retrieves the version of the n8n instance, e.g. "1.64.0".
+//
+// The public API does not expose the version, so it is read from the
+// settings served to the n8n editor, which do not require authentication.
+func (c *Client) GetVersion() (string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/settings", c.HostURL), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var settings struct {
+		Data struct {
+			VersionCli string `json:"versionCli"`
+		} `json:"data"`
+	}
+	if err := c.doJSONRequest(req, &settings); err != nil {
+		return "", err
+	}
+
+	if settings.Data.VersionCli == "" {
+		return "", fmt.Errorf("the instance settings do not include its version")
+	}
+	return settings.Data.VersionCli, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVersion(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rest/settings", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data": {"versionCli": "1.64.0", "timezone": "UTC"}}`))
+	})
+
+	version, err := client.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, "1.64.0", version)
+}
+
+func TestGetVersion_Missing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	})
+
+	_, err := client.GetVersion()
+	require.Error(t, err)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WebhookRegistered reports whether n8n serves a production webhook on the
+// given path, relative to /webhook/. It sends a CORS preflight request, which
+// n8n answers for registered paths and rejects with 404 otherwise, so the
+// workflow is not executed.
+//
+// Parameters:
+//   - path: the path of the Webhook node.
+//
+// Returns whether the webhook is registered, or an error if the request fails
+// or n8n answers with an unexpected status.
+func (c *Client) WebhookRegistered(path string) (bool, error) {
+	req, err := http.NewRequest("OPTIONS", fmt.Sprintf("%s/webhook/%s", c.HostURL, strings.Trim(path, "/")), nil)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxDrainedBodySize))
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return false, nil
+	case res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices:
+		return true, nil
+	default:
+		return false, fmt.Errorf("status: %d", res.StatusCode)
+	}
+}
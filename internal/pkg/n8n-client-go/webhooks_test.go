@@ -0,0 +1,38 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRegistered(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodOptions, r.Method)
+		require.Empty(t, r.Header.Get("X-N8N-API-KEY"), "the API key is not sent to webhooks")
+
+		switch r.URL.Path {
+		case "/webhook/orders":
+			w.WriteHeader(http.StatusNoContent)
+		case "/webhook/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	registered, err := client.WebhookRegistered("/orders")
+	require.NoError(t, err)
+	require.True(t, registered)
+
+	registered, err = client.WebhookRegistered("missing")
+	require.NoError(t, err)
+	require.False(t, registered)
+
+	_, err = client.WebhookRegistered("broken")
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ package n8n
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,42 +13,78 @@ import (
 
 // GetWorkflows retrieves all workflows from your n8n instance.
 // This method supports pagination and will automatically iterate through
-// all available pages by following the cursor in the response.
+// all available pages by following the cursor in the response. Options
+// filter the workflows and bound the number retrieved.
 //
 // Returns a pointer to a WorkflowsResponse containing all workflows,
 // or an error if the request or response decoding fails.
-func (c *Client) GetWorkflows() (*WorkflowsResponse, error) {
-	var allWorkflows WorkflowsResponse
-	cursor := ""
+func (c *Client) GetWorkflows(opts ...ListOption) (*WorkflowsResponse, error) {
+	return c.listWorkflows(opts)
+}
+
+// GetProjectWorkflows retrieves all workflows of the project with the given
+// ID, following the pagination cursor like GetWorkflows.
+func (c *Client) GetProjectWorkflows(projectID string, opts ...ListOption) (*WorkflowsResponse, error) {
+	return c.listWorkflows(append([]ListOption{withQuery("projectId", projectID)}, opts...))
+}
+
+// ForEachWorkflow calls fn with each workflow matching the filter options,
+// requesting one page at a time, so that the workflows of large instances are
+// never all held in memory. It stops at the first error returned by fn or by a
+// request, or once ctx is done, and returns that error.
+func (c *Client) ForEachWorkflow(ctx context.Context, filter []ListOption, fn func(Workflow) error) error {
+	options := c.workflowListOptions(filter)
 
+	collected := 0
 	for {
-		url := fmt.Sprintf("%s/api/v1/workflows", c.HostURL)
-		// Only append the cursor if it's not empty
-		if cursor != "" {
-			url = fmt.Sprintf("%s?cursor=%s", url, cursor)
+		if err := ctx.Err(); err != nil {
+			return err
 		}
 
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return nil, err
+		var workflows WorkflowsResponse
+		if err := c.getPageContext(ctx, options.pageURL(fmt.Sprintf("%s/api/v1/workflows", c.HostURL), collected), &workflows); err != nil {
+			return err
 		}
 
-		body, err := c.doRequest(req)
-		if err != nil {
-			return nil, err
+		for _, workflow := range workflows.Data {
+			if err := fn(workflow); err != nil {
+				return err
+			}
 		}
 
+		collected += len(workflows.Data)
+		if !options.next(workflows.NextCursor, collected) {
+			return nil
+		}
+	}
+}
+
+// workflowListOptions returns the options of a call listing workflows.
+func (c *Client) workflowListOptions(opts []ListOption) *listOptions {
+	options := newListOptions(0, opts)
+	if c.ExcludePinnedData {
+		options.query.Set("excludePinnedData", "true")
+	}
+	return options
+}
+
+// listWorkflows retrieves the workflows matching the options, one page at a
+// time.
+func (c *Client) listWorkflows(opts []ListOption) (*WorkflowsResponse, error) {
+	var allWorkflows WorkflowsResponse
+	options := c.workflowListOptions(opts)
+
+	for {
 		var workflows WorkflowsResponse
-		err = json.Unmarshal(body, &workflows)
-		if err != nil {
+		if err := c.getPage(options.pageURL(fmt.Sprintf("%s/api/v1/workflows", c.HostURL), len(allWorkflows.Data)), &workflows); err != nil {
 			return nil, err
 		}
 
 		allWorkflows.Data = append(allWorkflows.Data, workflows.Data...)
-		if workflows.NextCursor == nil {
+		allWorkflows.NextCursor = workflows.NextCursor
+		if !options.next(workflows.NextCursor, len(allWorkflows.Data)) {
 			break
 		}
-		cursor = *workflows.NextCursor
 	}
 
 	return &allWorkflows, nil
@@ -60,23 +97,64 @@ func (c *Client) GetWorkflows() (*WorkflowsResponse, error) {
 //
 // Returns a pointer to the Workflow struct, or an error if the request or decoding fails.
 func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, workflowID), nil)
+	req, err := http.NewRequest("GET", c.workflowURL(workflowID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
+	workflow := Workflow{}
+	if err := c.doJSONRequest(req, &workflow); err != nil {
 		return nil, err
 	}
 
-	workflow := Workflow{}
-	err = json.Unmarshal(body, &workflow)
+	return &workflow, nil
+}
+
+// workflowURL returns the URL reading the workflow with the given ID.
+func (c *Client) workflowURL(workflowID string) string {
+	endpoint := fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, workflowID)
+	if c.ExcludePinnedData {
+		endpoint += "?excludePinnedData=true"
+	}
+	return endpoint
+}
+
+// GetWorkflowIfChanged retrieves a workflow unless it still matches the given
+// entity tag, as returned by a previous call. Instances that do not support
+// entity tags, directly or through a caching proxy, always return the
+// workflow.
+//
+// Parameters:
+//   - workflowID: the unique identifier of the workflow.
+//   - etag: the entity tag of the copy held by the caller, empty if none.
+//
+// Returns the Workflow, or nil if it did not change, along with its current
+// entity tag, or an error if the request or decoding fails.
+func (c *Client) GetWorkflowIfChanged(workflowID string, etag string) (*Workflow, string, error) {
+	req, err := http.NewRequest("GET", c.workflowURL(workflowID), nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
 	}
 
-	return &workflow, nil
+	res, err := c.send(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified {
+		return nil, etag, nil
+	}
+
+	workflow := Workflow{}
+	if err := json.NewDecoder(res.Body).Decode(&workflow); err != nil {
+		return nil, "", fmt.Errorf("decoding response: %w", err)
+	}
+
+	return &workflow, res.Header.Get("ETag"), nil
 }
 
 // DeleteWorkflow deletes a workflow from your n8n instance by its ID.
@@ -91,14 +169,8 @@ func (c *Client) DeleteWorkflow(workflowID string) (*Workflow, error) {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
 	workflow := Workflow{}
-	err = json.Unmarshal(body, &workflow)
-	if err != nil {
+	if err := c.doJSONRequest(req, &workflow); err != nil {
 		return nil, err
 	}
 
@@ -118,13 +190,8 @@ func (c *Client) DeactivateWorkflow(workflowID string) (*Workflow, error) {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
 	workflow := Workflow{}
-	if err := json.Unmarshal(body, &workflow); err != nil {
+	if err := c.doJSONRequest(req, &workflow); err != nil {
 		return nil, err
 	}
 
@@ -144,13 +211,8 @@ func (c *Client) ActivateWorkflow(workflowID string) (*Workflow, error) {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
 	workflow := Workflow{}
-	if err := json.Unmarshal(body, &workflow); err != nil {
+	if err := c.doJSONRequest(req, &workflow); err != nil {
 		return nil, err
 	}
 
@@ -179,16 +241,9 @@ func (c *Client) CreateWorkflow(createWorkflowRequest *CreateWorkflowRequest) (*
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	// Perform the request
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-
-	// Decode the response into a Workflow
 	workflow := &Workflow{}
-	if err := json.Unmarshal(body, workflow); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.doJSONRequest(req, workflow); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	return workflow, nil
@@ -217,16 +272,9 @@ func (c *Client) UpdateWorkflow(id string, updateWorkflowRequest *UpdateWorkflow
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	// Perform the request
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-
-	// Decode the response into a Workflow
 	workflow := &Workflow{}
-	if err := json.Unmarshal(body, workflow); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.doJSONRequest(req, workflow); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	return workflow, nil
@@ -4,7 +4,9 @@
 package n8n
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"path"
@@ -59,6 +61,90 @@ func TestGetWorkflows(t *testing.T) {
 	}
 }
 
+func TestForEachWorkflow(t *testing.T) {
+	pages := map[string]string{
+		"":    `{"data": [{"id": "1", "name": "Workflow 1"}, {"id": "2", "name": "Workflow 2"}], "nextCursor": "abc"}`,
+		"abc": `{"data": [{"id": "3", "name": "Workflow 3"}], "nextCursor": null}`,
+	}
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		_, _ = w.Write([]byte(pages[r.URL.Query().Get("cursor")]))
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	var ids []string
+	err = client.ForEachWorkflow(context.Background(), []ListOption{WithActive(true)}, func(workflow Workflow) error {
+		ids = append(ids, workflow.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3"}, ids)
+	require.Equal(t, []string{"active=true", "active=true&cursor=abc"}, queries)
+
+	// The listing stops at the first error of the callback
+	queries = nil
+	stop := errors.New("stop")
+	err = client.ForEachWorkflow(context.Background(), nil, func(workflow Workflow) error {
+		return stop
+	})
+	require.ErrorIs(t, err, stop)
+	require.Len(t, queries, 1)
+
+	// and is not started once the context is done
+	queries = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = client.ForEachWorkflow(ctx, nil, func(workflow Workflow) error { return nil })
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, queries)
+}
+
+func TestGetProjectWorkflows(t *testing.T) {
+	mockResponses := []string{
+		`{"data": [{"id": "3LODqkaWPmYOi0FA", "name": "Workflow 1"}], "nextCursor": "abc"}`,
+		`{"data": [{"id": "if4hSGz1GkaYMLTq", "name": "Workflow 2"}], "nextCursor": null}`,
+	}
+	requestCount := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("projectId") != "project-1" {
+			t.Errorf("expected projectId 'project-1', got '%s'", query.Get("projectId"))
+		}
+		if requestCount == 1 && query.Get("cursor") != "abc" {
+			t.Errorf("expected cursor 'abc', got '%s'", query.Get("cursor"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(mockResponses[requestCount])); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+		requestCount++
+	})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	workflows, err := client.GetProjectWorkflows("project-1")
+	if err != nil {
+		t.Fatalf("GetProjectWorkflows returned an error: %v", err)
+	}
+
+	if len(workflows.Data) != 2 {
+		t.Errorf("expected 2 workflows, got %d", len(workflows.Data))
+	}
+}
+
 func TestGetWorkflow(t *testing.T) {
 	mockResponse := `{"id": "3LODqkaWPmYOi0FA", "name": "Test Workflow"}`
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -90,6 +176,71 @@ func TestGetWorkflow(t *testing.T) {
 	}
 }
 
+func TestGetWorkflowIfChanged(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v2"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v2"`)
+		_, _ = w.Write([]byte(`{"id": "1", "name": "Test Workflow"}`))
+	})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow, etag, err := client.GetWorkflowIfChanged("1", "")
+	require.NoError(t, err)
+	require.NotNil(t, workflow)
+	require.Equal(t, `"v2"`, etag)
+
+	workflow, etag, err = client.GetWorkflowIfChanged("1", `"v1"`)
+	require.NoError(t, err)
+	require.NotNil(t, workflow, "a stale tag returns the workflow")
+	require.Equal(t, `"v2"`, etag)
+
+	workflow, etag, err = client.GetWorkflowIfChanged("1", `"v2"`)
+	require.NoError(t, err)
+	require.Nil(t, workflow, "an unchanged workflow is not returned")
+	require.Equal(t, `"v2"`, etag)
+}
+
+func TestExcludePinnedData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "true", r.URL.Query().Get("excludePinnedData"))
+		if r.URL.Path == "/api/v1/workflows" {
+			_, _ = w.Write([]byte(`{"data": [{"id": "1"}], "nextCursor": null}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "1"}`))
+	})
+	client.ExcludePinnedData = true
+
+	_, err := client.GetWorkflow("1")
+	require.NoError(t, err)
+	_, _, err = client.GetWorkflowIfChanged("1", "")
+	require.NoError(t, err)
+	_, err = client.GetWorkflows()
+	require.NoError(t, err)
+}
+
+func TestWithPinnedData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.False(t, r.URL.Query().Has("excludePinnedData"))
+		_, _ = w.Write([]byte(`{"id": "1", "pinData": {"Webhook": [{"json": {}}]}}`))
+	})
+	client.ExcludePinnedData = true
+
+	workflow, err := client.WithPinnedData().GetWorkflow("1")
+	require.NoError(t, err)
+	require.Contains(t, workflow.Extra, "pinData")
+	require.True(t, client.ExcludePinnedData, "the original client still leaves pinned data out")
+}
+
 func TestDeleteWorkflow(t *testing.T) {
 	mockID := "3LODqkaWPmYOi0FA"
 	mockResponse := `{"id": "3LODqkaWPmYOi0FA", "name": "Test Workflow"}`
@@ -263,7 +414,7 @@ func TestCreateWorkflow(t *testing.T) {
 		},
 		Connections: map[string]Connection{},
 		Settings: Settings{
-			ExecutionOrder: "v1",
+			ExecutionOrder: Ptr("v1"),
 		},
 	}
 
@@ -365,11 +516,11 @@ func TestUpdateWorkflow(t *testing.T) {
 		},
 		Connections: map[string]Connection{
 			"Start": {
-				Main: json.RawMessage(`[[{"node":"Set Node","type":"main","index":0}]]`),
+				"main": json.RawMessage(`[[{"node":"Set Node","type":"main","index":0}]]`),
 			},
 		},
 		Settings: Settings{
-			ExecutionOrder: "v1",
+			ExecutionOrder: Ptr("v1"),
 		},
 	}
 
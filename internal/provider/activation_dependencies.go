@@ -0,0 +1,73 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// activationDependencyTimeout bounds the time waitForActiveWorkflows waits for
+// the workflows listed in activate_after to become active.
+var activationDependencyTimeout = 2 * time.Minute
+
+// activationDependencyInterval is the delay between two checks of a workflow
+// that is not active yet.
+var activationDependencyInterval = time.Second
+
+// waitForActivationDependencies waits for the workflows of an activate_after
+// attribute to be active, see waitForActiveWorkflows.
+func waitForActivationDependencies(ctx context.Context, client *n8n.Client, workflowID string, activateAfter types.List) error {
+	if activateAfter.IsNull() || activateAfter.IsUnknown() {
+		return nil
+	}
+
+	var dependencies []string
+	if diags := activateAfter.ElementsAs(ctx, &dependencies, false); diags.HasError() {
+		return fmt.Errorf("invalid activate_after: %v", diags)
+	}
+	return waitForActiveWorkflows(ctx, client, workflowID, dependencies)
+}
+
+// waitForActiveWorkflows polls each of the dependencies of a workflow until it
+// is active, or returns an error once activationDependencyTimeout has passed.
+// Dependencies managed in the same apply without a reference to them are
+// activated concurrently, so waiting lets their activation complete first.
+func waitForActiveWorkflows(ctx context.Context, client *n8n.Client, workflowID string, dependencies []string) error {
+	deadline := time.Now().Add(activationDependencyTimeout)
+
+	for _, dependency := range dependencies {
+		if dependency == workflowID {
+			return fmt.Errorf("workflow %s cannot be activated after itself, remove its ID from activate_after", workflowID)
+		}
+
+		for {
+			workflow, err := client.GetWorkflow(dependency)
+			if err != nil {
+				return fmt.Errorf("unable to read workflow %s listed in activate_after: %w", dependency, err)
+			}
+			if workflow.Active {
+				break
+			}
+
+			tflog.Debug(ctx, "Waiting for workflow to be active before activating", map[string]any{"id": workflowID, "dependency": dependency})
+
+			if time.Now().After(deadline) {
+				return fmt.Errorf("workflow %s (%s) listed in activate_after is still inactive after %s. Activate it first, or remove it from activate_after", workflow.ID, workflow.Name, activationDependencyTimeout)
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(activationDependencyInterval):
+			}
+		}
+	}
+	return nil
+}
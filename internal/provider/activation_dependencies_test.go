@@ -0,0 +1,47 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForActiveWorkflows(t *testing.T) {
+	activationDependencyInterval = time.Millisecond
+	t.Cleanup(func() { activationDependencyInterval = time.Second })
+
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows/errors", http.StatusOK, `{"id": "errors", "name": "Error handler", "active": true}`)
+	checks := 0
+	server.Handle("GET /api/v1/workflows/sub", func(w http.ResponseWriter, _ *http.Request) {
+		checks++
+		// The sub-workflow is activated by a concurrent operation on the third check
+		if checks >= 3 {
+			_, _ = w.Write([]byte(`{"id": "sub", "name": "Sub-workflow", "active": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "sub", "name": "Sub-workflow", "active": false}`))
+	})
+
+	require.NoError(t, waitForActiveWorkflows(context.Background(), server.Client(), "main", []string{"errors", "sub"}))
+	assert.Equal(t, 3, checks)
+
+	activationDependencyTimeout = 10 * time.Millisecond
+	t.Cleanup(func() { activationDependencyTimeout = 2 * time.Minute })
+
+	server.Respond("GET /api/v1/workflows/draft", http.StatusOK, `{"id": "draft", "name": "Draft", "active": false}`)
+	err := waitForActiveWorkflows(context.Background(), server.Client(), "main", []string{"draft"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workflow draft (Draft) listed in activate_after is still inactive")
+
+	err = waitForActiveWorkflows(context.Background(), server.Client(), "main", []string{"main"})
+	assert.ErrorContains(t, err, "cannot be activated after itself")
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiKeyScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiKeyScopesDataSource{}
+)
+
+// NewAPIKeyScopesDataSource returns a new data source.
+func NewAPIKeyScopesDataSource() datasource.DataSource {
+	return &apiKeyScopesDataSource{}
+}
+
+type apiKeyScopesDataSource struct {
+	client *n8n.Client
+}
+
+type apiKeyScopesDataSourceModel struct {
+	Granted types.List `tfsdk:"granted"`
+	Missing types.List `tfsdk:"missing"`
+}
+
+func (d *apiKeyScopesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *apiKeyScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key_scopes"
+}
+
+func (d *apiKeyScopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detects the scopes of the API key configured in the provider, to check that it grants what a configuration needs before applying it. The public API has no endpoint listing the scopes of a key, so only the scopes of requests without side effects are detected, by sending one such request per scope: `workflow:list`, `tag:list` and `execution:list`. Requests refused for a missing scope report the scope in their error.",
+		Attributes: map[string]schema.Attribute{
+			"granted": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted detected scopes granted to the API key.",
+			},
+			"missing": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted detected scopes the API key lacks.",
+			},
+		},
+	}
+}
+
+func (d *apiKeyScopesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	probed, err := d.client.ProbeScopes()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Detect n8n API Key Scopes", err.Error())
+		return
+	}
+
+	granted, missing := splitScopes(probed)
+
+	var state apiKeyScopesDataSourceModel
+	var diags diag.Diagnostics
+	state.Granted, diags = types.ListValueFrom(ctx, types.StringType, granted)
+	resp.Diagnostics.Append(diags...)
+	state.Missing, diags = types.ListValueFrom(ctx, types.StringType, missing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// splitScopes returns the sorted granted and missing scopes of the result of
+// n8n.Client.ProbeScopes.
+func splitScopes(probed map[string]bool) (granted, missing []string) {
+	granted, missing = []string{}, []string{}
+	for scope, ok := range probed {
+		if ok {
+			granted = append(granted, scope)
+		} else {
+			missing = append(missing, scope)
+		}
+	}
+	sort.Strings(granted)
+	sort.Strings(missing)
+	return granted, missing
+}
@@ -0,0 +1,20 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitScopes(t *testing.T) {
+	granted, missing := splitScopes(map[string]bool{"workflow:list": true, "tag:list": false, "execution:list": true})
+	assert.Equal(t, []string{"execution:list", "workflow:list"}, granted)
+	assert.Equal(t, []string{"tag:list"}, missing)
+
+	granted, missing = splitScopes(nil)
+	assert.Empty(t, granted)
+	assert.NotNil(t, missing, "empty lists rather than null")
+}
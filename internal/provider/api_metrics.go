@@ -0,0 +1,47 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiMetricsKey is the context key of the metrics of the current operation.
+type apiMetricsKey struct{}
+
+// trackAPICalls returns a context in which the clients returned by
+// meteredClient record their API calls, and a function logging a summary of
+// these calls at the DEBUG level, to be deferred until the operation ends.
+// The summary helps finding out why plans are slow against a remote instance.
+func trackAPICalls(ctx context.Context, operation string) (context.Context, func()) {
+	metrics := &n8n.CallMetrics{}
+	ctx = context.WithValue(ctx, apiMetricsKey{}, metrics)
+
+	return ctx, func() {
+		stats := metrics.Stats()
+		if stats.Calls == 0 {
+			return
+		}
+		tflog.Debug(ctx, "n8n API calls", map[string]any{
+			"operation":   operation,
+			"calls":       stats.Calls,
+			"retries":     stats.Retries,
+			"rateLimited": stats.RateLimited,
+			"latencyMs":   stats.Latency.Milliseconds(),
+		})
+	}
+}
+
+// meteredClient returns a copy of client recording its calls in the metrics
+// of the operation tracked in ctx, or client itself when there are none.
+func meteredClient(ctx context.Context, client *n8n.Client) *n8n.Client {
+	metrics, ok := ctx.Value(apiMetricsKey{}).(*n8n.CallMetrics)
+	if !ok || client == nil {
+		return client
+	}
+	return client.WithMetrics(metrics)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeteredClient(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/tags", 200, `{"data":[],"nextCursor":null}`)
+	client := server.Client()
+
+	assert.Same(t, client, meteredClient(context.Background(), client), "no operation tracked")
+	assert.Nil(t, meteredClient(context.Background(), nil))
+
+	ctx, logAPICalls := trackAPICalls(context.Background(), "read")
+	defer logAPICalls()
+
+	metered := meteredClient(ctx, client)
+	require.NotSame(t, client, metered)
+	_, err := metered.GetTags()
+	require.NoError(t, err)
+	_, err = meteredClient(ctx, client).GetTags()
+	require.NoError(t, err)
+
+	metrics := ctx.Value(apiMetricsKey{}).(*n8n.CallMetrics)
+	assert.Equal(t, 2, metrics.Stats().Calls)
+}
@@ -0,0 +1,27 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"os"
+	"os/user"
+)
+
+// auditActor returns the user running Terraform, as user@host, to whom the
+// entries of the audit log are attributed. The n8n API does not tell which
+// user an API key belongs to.
+func auditActor() string {
+	name := os.Getenv("USER")
+	if current, err := user.Current(); err == nil {
+		name = current.Username
+	}
+	if name == "" {
+		name = "unknown"
+	}
+
+	if host, err := os.Hostname(); err == nil && host != "" {
+		name += "@" + host
+	}
+	return name
+}
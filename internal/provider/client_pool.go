@@ -0,0 +1,127 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sync"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// endpointClients holds the clients of resources that override the provider
+// endpoint. It lives for the duration of the provider process.
+var endpointClients = newClientPool()
+
+// clientPoolKey identifies the clients of a clientPool.
+type clientPoolKey struct {
+	host  string
+	token string
+}
+
+// clientPool shares n8n clients between resources that target the same
+// instance, so that a configuration managing many instances with for_each
+// creates one client, and one HTTP connection pool, per instance.
+type clientPool struct {
+	mu          sync.Mutex
+	clients     map[clientPoolKey]*n8n.Client
+	dumpDir     string
+	tracer      trace.Tracer
+	traceParent trace.SpanContext
+
+	excludePinnedData bool
+	readOnly          bool
+	audit             *n8n.AuditLog
+}
+
+// newClientPool returns an empty client pool.
+func newClientPool() *clientPool {
+	return &clientPool{clients: make(map[clientPoolKey]*n8n.Client)}
+}
+
+// get returns the client for the given host and token, creating it on first use.
+func (p *clientPool) get(host, token string) (*n8n.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := clientPoolKey{host: host, token: token}
+	if client, ok := p.clients[key]; ok {
+		return client, nil
+	}
+
+	client, err := n8n.NewClient(&host, &token)
+	if err != nil {
+		return nil, err
+	}
+	client.DumpDir = p.dumpDir
+	client.Tracer = p.tracer
+	client.TraceParent = p.traceParent
+	client.ExcludePinnedData = p.excludePinnedData
+	client.ReadOnly = p.readOnly
+	client.Audit = p.audit
+	p.clients[key] = client
+	return client, nil
+}
+
+// setDumpDir sets the directory where the clients of the pool dump the API
+// payloads, see n8n.Client.DumpDir.
+func (p *clientPool) setDumpDir(dir string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.dumpDir = dir
+	for _, client := range p.clients {
+		client.DumpDir = dir
+	}
+}
+
+// setTracing sets the tracer of the clients of the pool and the parent of
+// their spans, see n8n.Client.Tracer.
+func (p *clientPool) setTracing(tracer trace.Tracer, parent trace.SpanContext) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tracer = tracer
+	p.traceParent = parent
+	for _, client := range p.clients {
+		client.Tracer = tracer
+		client.TraceParent = parent
+	}
+}
+
+// setExcludePinnedData sets whether the clients of the pool leave pinned data
+// out of workflows, see n8n.Client.ExcludePinnedData.
+func (p *clientPool) setExcludePinnedData(exclude bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.excludePinnedData = exclude
+	for _, client := range p.clients {
+		client.ExcludePinnedData = exclude
+	}
+}
+
+// setReadOnly sets whether the clients of the pool refuse requests changing
+// the instance, see n8n.Client.ReadOnly.
+func (p *clientPool) setReadOnly(readOnly bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.readOnly = readOnly
+	for _, client := range p.clients {
+		client.ReadOnly = readOnly
+	}
+}
+
+// setAudit sets the audit log of the clients of the pool, see
+// n8n.Client.Audit.
+func (p *clientPool) setAudit(audit *n8n.AuditLog) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.audit = audit
+	for _, client := range p.clients {
+		client.Audit = audit
+	}
+}
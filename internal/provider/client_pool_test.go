@@ -0,0 +1,77 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPool(t *testing.T) {
+	pool := newClientPool()
+
+	first, err := pool.get("https://a.example", "token-a")
+	require.NoError(t, err)
+	assert.Equal(t, "https://a.example", first.HostURL)
+	assert.Equal(t, "token-a", first.Token)
+
+	again, err := pool.get("https://a.example", "token-a")
+	require.NoError(t, err)
+	assert.Same(t, first, again, "clients should be reused for the same endpoint")
+
+	otherToken, err := pool.get("https://a.example", "token-b")
+	require.NoError(t, err)
+	assert.NotSame(t, first, otherToken)
+
+	otherHost, err := pool.get("https://b.example", "token-a")
+	require.NoError(t, err)
+	assert.NotSame(t, first, otherHost)
+}
+
+func TestClientPoolExcludePinnedData(t *testing.T) {
+	pool := newClientPool()
+
+	existing, err := pool.get("https://a.example", "token-a")
+	require.NoError(t, err)
+	assert.False(t, existing.ExcludePinnedData)
+
+	pool.setExcludePinnedData(true)
+	assert.True(t, existing.ExcludePinnedData, "existing clients are updated")
+
+	created, err := pool.get("https://b.example", "token-b")
+	require.NoError(t, err)
+	assert.True(t, created.ExcludePinnedData, "new clients inherit the setting")
+}
+
+func TestClientPoolReadOnly(t *testing.T) {
+	pool := newClientPool()
+
+	existing, err := pool.get("https://a.example", "token-a")
+	require.NoError(t, err)
+
+	pool.setReadOnly(true)
+	assert.True(t, existing.ReadOnly, "existing clients are updated")
+
+	created, err := pool.get("https://b.example", "token-b")
+	require.NoError(t, err)
+	assert.True(t, created.ReadOnly, "new clients inherit the setting")
+}
+
+func TestClientPoolAudit(t *testing.T) {
+	pool := newClientPool()
+
+	existing, err := pool.get("https://a.example", "token-a")
+	require.NoError(t, err)
+
+	audit := &n8n.AuditLog{}
+	pool.setAudit(audit)
+	assert.Same(t, audit, existing.Audit, "existing clients are updated")
+
+	created, err := pool.get("https://b.example", "token-b")
+	require.NoError(t, err)
+	assert.Same(t, audit, created.Audit, "new clients inherit the setting")
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// workflowConsistencyAttempts is the number of reads made by
+// readWorkflowAfterWrite before giving up.
+const workflowConsistencyAttempts = 5
+
+// workflowConsistencyBackoff is the delay before the second read, doubled
+// after each stale read.
+var workflowConsistencyBackoff = 250 * time.Millisecond
+
+// activationVerificationDelay is the time given to n8n to register the
+// triggers of an activated workflow before verifyWorkflowActivation reads it.
+var activationVerificationDelay = 5 * time.Second
+
+// readWorkflowAfterWrite reads back a workflow that was just written, retrying
+// while the instance serves data older than the write. Clustered installs
+// behind a load balancer can return stale data right after a write, which
+// would show up as a phantom diff on the next plan. When no read catches up,
+// the written workflow is returned as is.
+func readWorkflowAfterWrite(ctx context.Context, client *n8n.Client, written *n8n.Workflow) *n8n.Workflow {
+	delay := workflowConsistencyBackoff
+	for attempt := 1; attempt <= workflowConsistencyAttempts; attempt++ {
+		read, err := client.GetWorkflow(written.ID)
+		if err == nil && workflowReadIsConsistent(written, read) {
+			return read
+		}
+
+		tflog.Debug(ctx, "Workflow read is not consistent with the last write", map[string]any{"id": written.ID, "attempt": attempt, "error": err})
+
+		if attempt == workflowConsistencyAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return written
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	tflog.Warn(ctx, "Workflow reads did not catch up with the last write, using the write response", map[string]any{"id": written.ID})
+	return written
+}
+
+// workflowReadIsConsistent reports whether a read reflects a write: either it
+// returns the written version and activation state, or a later update.
+func workflowReadIsConsistent(written, read *n8n.Workflow) bool {
+	if read.VersionId == written.VersionId {
+		return read.Active == written.Active
+	}
+	return read.UpdatedAt > written.UpdatedAt
+}
+
+// verifyWorkflowActivation reads an active workflow again after a delay and
+// returns an error when n8n deactivated it in the meantime, along with the
+// workflow as read.
+func verifyWorkflowActivation(ctx context.Context, client *n8n.Client, workflow *n8n.Workflow) (*n8n.Workflow, error) {
+	if !workflow.Active {
+		return workflow, errors.New("n8n did not activate the workflow. Check its triggers in the n8n editor")
+	}
+
+	select {
+	case <-ctx.Done():
+		return workflow, ctx.Err()
+	case <-time.After(activationVerificationDelay):
+	}
+
+	read, err := client.GetWorkflow(workflow.ID)
+	if err != nil {
+		return workflow, fmt.Errorf("unable to verify the activation: %w", err)
+	}
+	if !read.Active {
+		return read, fmt.Errorf("n8n deactivated workflow %s after activating it, usually because a trigger could not be registered, e.g. an invalid cron expression or a webhook path used by another workflow. Check the workflow in the n8n editor", read.ID)
+	}
+	return read, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWorkflowAfterWrite(t *testing.T) {
+	workflowConsistencyBackoff = time.Millisecond
+	t.Cleanup(func() { workflowConsistencyBackoff = 250 * time.Millisecond })
+
+	written := &n8n.Workflow{ID: "wf1", VersionId: "v2", Active: true, UpdatedAt: "2025-01-02T00:00:00.000Z"}
+
+	tests := []struct {
+		name          string
+		responses     []string
+		expectedReads int
+		expected      string
+	}{
+		{
+			name:          "consistent on first read",
+			responses:     []string{`{"id": "wf1", "versionId": "v2", "active": true, "name": "fresh"}`},
+			expectedReads: 1,
+			expected:      "fresh",
+		},
+		{
+			name: "stale then consistent",
+			responses: []string{
+				`{"id": "wf1", "versionId": "v1", "active": false, "updatedAt": "2025-01-01T00:00:00.000Z", "name": "stale"}`,
+				`{"id": "wf1", "versionId": "v2", "active": false, "name": "stale"}`,
+				`{"id": "wf1", "versionId": "v2", "active": true, "name": "fresh"}`,
+			},
+			expectedReads: 3,
+			expected:      "fresh",
+		},
+		{
+			name:          "later update",
+			responses:     []string{`{"id": "wf1", "versionId": "v3", "updatedAt": "2025-01-03T00:00:00.000Z", "name": "fresh"}`},
+			expectedReads: 1,
+			expected:      "fresh",
+		},
+		{
+			name:          "never consistent",
+			responses:     []string{`{"id": "wf1", "versionId": "v1", "updatedAt": "2025-01-01T00:00:00.000Z", "name": "stale"}`},
+			expectedReads: workflowConsistencyAttempts,
+			expected:      "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reads := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := tt.responses[min(reads, len(tt.responses)-1)]
+				reads++
+				_, _ = w.Write([]byte(response))
+			}))
+			defer ts.Close()
+
+			token := "test-token"
+			client, err := n8n.NewClient(&ts.URL, &token)
+			require.NoError(t, err)
+
+			workflow := readWorkflowAfterWrite(context.Background(), client, written)
+			assert.Equal(t, tt.expected, workflow.Name)
+			assert.Equal(t, tt.expectedReads, reads)
+		})
+	}
+}
+
+func TestVerifyWorkflowActivation(t *testing.T) {
+	activationVerificationDelay = time.Millisecond
+	t.Cleanup(func() { activationVerificationDelay = 5 * time.Second })
+
+	active := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if active {
+			_, _ = w.Write([]byte(`{"id": "wf1", "active": true}`))
+		} else {
+			_, _ = w.Write([]byte(`{"id": "wf1", "active": false}`))
+		}
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow, err := verifyWorkflowActivation(context.Background(), client, &n8n.Workflow{ID: "wf1", Active: true})
+	require.NoError(t, err)
+	assert.True(t, workflow.Active)
+
+	active = false
+	workflow, err = verifyWorkflowActivation(context.Background(), client, &n8n.Workflow{ID: "wf1", Active: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deactivated workflow wf1")
+	assert.False(t, workflow.Active, "the workflow as read is returned")
+
+	_, err = verifyWorkflowActivation(context.Background(), client, &n8n.Workflow{ID: "wf1"})
+	assert.Error(t, err, "a workflow that was never activated fails the verification")
+}
@@ -0,0 +1,39 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// contentHashDescription documents the content_hash attribute of the workflow
+// resource and data sources.
+const contentHashDescription = "SHA-256 hash of the workflow nodes, connections and settings in canonical JSON form. Changes only when the workflow content changes, so it can be used to detect changes or compare workflows across instances without diffing the full JSON."
+
+// workflowContent is the part of a workflow covered by the content hash.
+type workflowContent struct {
+	Nodes       []n8n.Node                `json:"nodes"`
+	Connections map[string]n8n.Connection `json:"connections"`
+	Settings    n8n.Settings              `json:"settings"`
+}
+
+// workflowContentHash returns the hex encoded SHA-256 hash of the canonical
+// JSON form of the workflow nodes, connections and settings. Metadata such as
+// the name, version ID and timestamps is not part of the hash.
+func workflowContentHash(workflow *n8n.Workflow) (string, error) {
+	content, err := workflowCanonicalJSON(workflowContent{
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testContentHashWorkflow() *n8n.Workflow {
+	return &n8n.Workflow{
+		ID:        "1",
+		Name:      "Workflow",
+		VersionId: "version-1",
+		Nodes: []n8n.Node{
+			{ID: "1", Name: "Start", Type: "n8n-nodes-base.manualTrigger", Parameters: map[string]interface{}{"a": 1, "b": "x"}},
+		},
+		Connections: map[string]n8n.Connection{
+			"Start": {"main": json.RawMessage(`[[{"node": "End", "type": "main", "index": 0}]]`)},
+		},
+		Settings: defaultWorkflowSettings(),
+	}
+}
+
+func TestWorkflowContentHash(t *testing.T) {
+	base, err := workflowContentHash(testContentHashWorkflow())
+	require.NoError(t, err)
+	assert.Len(t, base, 64)
+
+	t.Run("metadata is ignored", func(t *testing.T) {
+		workflow := testContentHashWorkflow()
+		workflow.Name = "Renamed"
+		workflow.VersionId = "version-2"
+		workflow.UpdatedAt = "2025-01-02T00:00:00.000Z"
+
+		hash, err := workflowContentHash(workflow)
+		require.NoError(t, err)
+		assert.Equal(t, base, hash)
+	})
+
+	t.Run("formatting is ignored", func(t *testing.T) {
+		workflow := testContentHashWorkflow()
+		workflow.Connections["Start"] = n8n.Connection{"main": json.RawMessage(`[[{"index":0,"node":"End","type":"main"}]]`)}
+
+		hash, err := workflowContentHash(workflow)
+		require.NoError(t, err)
+		assert.Equal(t, base, hash)
+	})
+
+	t.Run("content changes", func(t *testing.T) {
+		workflow := testContentHashWorkflow()
+		workflow.Nodes[0].Parameters["a"] = 2
+
+		hash, err := workflowContentHash(workflow)
+		require.NoError(t, err)
+		assert.NotEqual(t, base, hash)
+	})
+
+	t.Run("settings change", func(t *testing.T) {
+		workflow := testContentHashWorkflow()
+		workflow.Settings.Timezone = n8n.Ptr("UTC")
+
+		hash, err := workflowContentHash(workflow)
+		require.NoError(t, err)
+		assert.NotEqual(t, base, hash)
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialReference is a credential used by a workflow node, as found in
+// the node credentials block.
+type credentialReference struct {
+	Type string `tfsdk:"type"`
+	ID   string `tfsdk:"id"`
+	Name string `tfsdk:"name"`
+	Node string `tfsdk:"node"`
+}
+
+// credentialReferenceAttrTypes are the attribute types of a credential
+// reference returned by provider functions.
+var credentialReferenceAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+	"name": types.StringType,
+	"node": types.StringType,
+}
+
+// nodeCredentialReferences returns the credentials referenced by the given
+// nodes, ordered by node name then credential type.
+func nodeCredentialReferences(nodes []n8n.Node) []credentialReference {
+	references := []credentialReference{}
+	for _, node := range nodes {
+		for credentialType, value := range node.Credentials {
+			reference := credentialReference{Type: credentialType, Node: node.Name}
+			if fields, ok := value.(map[string]interface{}); ok {
+				reference.ID = credentialField(fields, "id")
+				reference.Name = credentialField(fields, "name")
+			}
+			references = append(references, reference)
+		}
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].Node != references[j].Node {
+			return references[i].Node < references[j].Node
+		}
+		return references[i].Type < references[j].Type
+	})
+	return references
+}
+
+// credentialField returns a field of a node credential reference as a string.
+// Older workflows may store IDs as numbers.
+func credentialField(fields map[string]interface{}, key string) string {
+	switch value := fields[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeCredentialReferences(t *testing.T) {
+	nodes := []n8n.Node{
+		{
+			Name: "Send message",
+			Credentials: map[string]interface{}{
+				"slackApi":      map[string]interface{}{"id": "12", "name": "Slack bot"},
+				"httpBasicAuth": map[string]interface{}{"id": float64(7), "name": "Legacy"},
+			},
+		},
+		{Name: "No credentials"},
+		{
+			Name: "Get document",
+			Credentials: map[string]interface{}{
+				"googleDocsOAuth2Api": map[string]interface{}{"name": "Google Docs account"},
+			},
+		},
+	}
+
+	assert.Equal(t, []credentialReference{
+		{Type: "googleDocsOAuth2Api", Name: "Google Docs account", Node: "Get document"},
+		{Type: "httpBasicAuth", ID: "7", Name: "Legacy", Node: "Send message"},
+		{Type: "slackApi", ID: "12", Name: "Slack bot", Node: "Send message"},
+	}, nodeCredentialReferences(nodes))
+
+	assert.Empty(t, nodeCredentialReferences(nil))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// credentialTypePolicy restricts the types of the credentials managed
+// workflows may use. An empty allowed list allows every type not disallowed.
+type credentialTypePolicy struct {
+	allowed    []string
+	disallowed []string
+}
+
+// enabled reports whether the policy restricts any credential type.
+func (p credentialTypePolicy) enabled() bool {
+	return len(p.allowed) > 0 || len(p.disallowed) > 0
+}
+
+// violations returns a description of each credential referenced by the given
+// nodes whose type the policy does not allow, ordered by node name then
+// credential type.
+func (p credentialTypePolicy) violations(nodes []n8n.Node) []string {
+	var violations []string
+	for _, reference := range nodeCredentialReferences(nodes) {
+		switch {
+		case slices.Contains(p.disallowed, reference.Type):
+			violations = append(violations, fmt.Sprintf("Node %q uses a credential of type %s, listed in the provider disallowed_credential_types.", reference.Node, reference.Type))
+		case len(p.allowed) > 0 && !slices.Contains(p.allowed, reference.Type):
+			violations = append(violations, fmt.Sprintf("Node %q uses a credential of type %s, missing from the provider allowed_credential_types.", reference.Node, reference.Type))
+		}
+	}
+	return violations
+}
+
+// refuseDisallowedCredentialTypes fails the plan of a workflow whose nodes use
+// credentials of a type the provider credential type policy does not allow.
+// Like the node type policy, every plan is checked and read-only workflows
+// are skipped.
+func (r *workflowResource) refuseDisallowedCredentialTypes(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	if !r.credentialTypes.enabled() {
+		return
+	}
+
+	var plan workflowResourceModel
+	if diags := resp.Plan.Get(ctx, &plan); diags.HasError() || plan.ReadOnly.ValueBool() || plan.Nodes.IsUnknown() || plan.Nodes.IsNull() {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	for _, violation := range r.credentialTypes.violations(nodes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("nodes"),
+			"Disallowed credential type",
+			fmt.Sprintf("Workflow %q: %s Use a credential of an allowed type.", plan.Name.ValueString(), violation),
+		)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialTypePolicyViolations(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Send mail", Credentials: map[string]interface{}{"gmailOAuth2": map[string]interface{}{"id": "1", "name": "Personal Gmail"}}},
+		{Name: "Notify", Credentials: map[string]interface{}{"slackOAuth2Api": map[string]interface{}{"id": "2", "name": "Slack"}}},
+		{Name: "Fetch", Credentials: map[string]interface{}{"httpHeaderAuth": map[string]interface{}{"id": "3", "name": "API key"}}},
+	}
+
+	assert.False(t, credentialTypePolicy{}.enabled())
+	assert.Empty(t, credentialTypePolicy{}.violations(nodes))
+
+	policy := credentialTypePolicy{disallowed: []string{"gmailOAuth2"}}
+	assert.Equal(t, []string{
+		`Node "Send mail" uses a credential of type gmailOAuth2, listed in the provider disallowed_credential_types.`,
+	}, policy.violations(nodes))
+
+	policy = credentialTypePolicy{allowed: []string{"slackOAuth2Api", "gmailOAuth2"}, disallowed: []string{"gmailOAuth2"}}
+	assert.Equal(t, []string{
+		`Node "Fetch" uses a credential of type httpHeaderAuth, missing from the provider allowed_credential_types.`,
+		`Node "Send mail" uses a credential of type gmailOAuth2, listed in the provider disallowed_credential_types.`,
+	}, policy.violations(nodes))
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// errorTriggerNodeType is the type of the node starting an error workflow.
+const errorTriggerNodeType = "n8n-nodes-base.errorTrigger"
+
+// errorWorkflowSettingPath is the path of the error workflow ID setting.
+var errorWorkflowSettingPath = path.Root("settings").AtName("error_workflow")
+
+// resolveErrorWorkflowName sets settings.error_workflow in the plan to the ID
+// of the workflow named by error_workflow_name, so that the usual settings
+// comparison applies. The ID is left unknown until the name and endpoint are,
+// and when no workflow has the name yet, so that an error workflow created in
+// the same apply is resolved once it exists.
+func (r *workflowResource) resolveErrorWorkflowName(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	var name types.String
+	var endpoint *endpointResourceModel
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("error_workflow_name"), &name)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("endpoint"), &endpoint)...)
+	if resp.Diagnostics.HasError() || name.IsNull() {
+		return
+	}
+
+	if name.IsUnknown() || (endpoint != nil && (endpoint.Host.IsUnknown() || endpoint.Token.IsUnknown())) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, errorWorkflowSettingPath, types.StringUnknown())...)
+		return
+	}
+
+	client, err := r.clientFor(ctx, endpoint)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Invalid endpoint", err.Error())
+		return
+	}
+	if client == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, errorWorkflowSettingPath, types.StringUnknown())...)
+		return
+	}
+
+	workflows, err := client.GetWorkflows()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	errorWorkflow, err := errorWorkflowNamed(workflows.Data, r.workflowNames.apply(name.ValueString()))
+	if errors.Is(err, errNoWorkflowNamed) {
+		tflog.Debug(ctx, "Deferring error workflow resolution to apply", map[string]any{"name": name.ValueString()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, errorWorkflowSettingPath, types.StringUnknown())...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("error_workflow_name"), "Unable to resolve error workflow", err.Error())
+		return
+	}
+
+	if !hasNodeOfType(errorWorkflow.Nodes, errorTriggerNodeType) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("error_workflow_name"),
+			"Error workflow has no Error Trigger",
+			fmt.Sprintf("Workflow %q (%s) has no Error Trigger node, so it will not run when this workflow fails.", errorWorkflow.Name, errorWorkflow.ID),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, errorWorkflowSettingPath, types.StringValue(errorWorkflow.ID))...)
+}
+
+// resolveDeferredErrorWorkflow sets settings.error_workflow of a plan to the
+// ID of the workflow named by error_workflow_name when it was left unknown at
+// plan time. Referencing the name of the error workflow resource orders its
+// creation before this one, so the workflow exists by now.
+func (r *workflowResource) resolveDeferredErrorWorkflow(client *n8n.Client, plan *workflowResourceModel) error {
+	if plan.ErrorWorkflowName.IsNull() || plan.Settings == nil || !plan.Settings.ErrorWorkflow.IsUnknown() {
+		return nil
+	}
+
+	workflows, err := client.GetWorkflows()
+	if err != nil {
+		return err
+	}
+
+	errorWorkflow, err := errorWorkflowNamed(workflows.Data, r.workflowNames.apply(plan.ErrorWorkflowName.ValueString()))
+	if err != nil {
+		return err
+	}
+	plan.Settings.ErrorWorkflow = types.StringValue(errorWorkflow.ID)
+	return nil
+}
+
+// errNoWorkflowNamed is returned by errorWorkflowNamed when no workflow has
+// the name.
+var errNoWorkflowNamed = errors.New("no workflow has the name")
+
+// errorWorkflowNamed returns the only workflow with the given name, or an
+// error when there is none or several.
+func errorWorkflowNamed(workflows []n8n.Workflow, name string) (*n8n.Workflow, error) {
+	var matches []n8n.Workflow
+	for _, workflow := range workflows {
+		if workflow.Name == name {
+			matches = append(matches, workflow)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: no workflow named %q exists", errNoWorkflowNamed, name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d workflows are named %q (%s); rename the duplicates or set settings.error_workflow to an ID", len(matches), name, strings.Join(workflowIDs(matches), ", "))
+	}
+}
+
+// hasNodeOfType reports whether one of the given nodes has the given type.
+func hasNodeOfType(nodes []n8n.Node, nodeType string) bool {
+	for _, node := range nodes {
+		if node.Type == nodeType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorWorkflowNamed(t *testing.T) {
+	workflows := []n8n.Workflow{
+		{ID: "wf1", Name: "Error handler", Nodes: []n8n.Node{{Type: errorTriggerNodeType}}},
+		{ID: "wf2", Name: "Orders"},
+		{ID: "wf3", Name: "Orders"},
+	}
+
+	workflow, err := errorWorkflowNamed(workflows, "Error handler")
+	require.NoError(t, err)
+	assert.Equal(t, "wf1", workflow.ID)
+	assert.True(t, hasNodeOfType(workflow.Nodes, errorTriggerNodeType))
+
+	_, err = errorWorkflowNamed(workflows, "Missing")
+	assert.ErrorContains(t, err, "no workflow named")
+	assert.ErrorIs(t, err, errNoWorkflowNamed)
+
+	_, err = errorWorkflowNamed(workflows, "Orders")
+	assert.ErrorContains(t, err, "wf2, wf3")
+}
+
+func TestResolveDeferredErrorWorkflow(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows", http.StatusOK, `{"data": [{"id": "errors", "name": "Error handler"}]}`)
+	r := &workflowResource{}
+
+	// Created earlier in the same apply, after the plan
+	plan := testWorkflowResourceModel()
+	plan.ErrorWorkflowName = types.StringValue("Error handler")
+	plan.Settings.ErrorWorkflow = types.StringUnknown()
+	require.NoError(t, r.resolveDeferredErrorWorkflow(server.Client(), &plan))
+	assert.Equal(t, "errors", plan.Settings.ErrorWorkflow.ValueString())
+
+	plan.ErrorWorkflowName = types.StringValue("Missing")
+	plan.Settings.ErrorWorkflow = types.StringUnknown()
+	assert.ErrorIs(t, r.resolveDeferredErrorWorkflow(server.Client(), &plan), errNoWorkflowNamed)
+
+	// Resolved at plan time
+	plan.Settings.ErrorWorkflow = types.StringValue("errors")
+	require.NoError(t, r.resolveDeferredErrorWorkflow(server.Client(), &plan))
+}
+
+func TestUpdateResolvesDeferredErrorWorkflowBeforeSkipping(t *testing.T) {
+	ctx := context.Background()
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows", http.StatusOK, `{"data": [{"id": "error-workflow", "name": "Error handler"}]}`)
+	r := &workflowResource{client: server.Client()}
+
+	state := testWorkflowResourceModel()
+	state.ErrorWorkflowName = types.StringValue("Error handler")
+
+	// The error workflow resolves to the one already set, nothing else changed
+	plan := state
+	plan.Settings = &settingsResourceModel{}
+	*plan.Settings = *state.Settings
+	plan.Settings.ErrorWorkflow = types.StringUnknown()
+
+	priorState := newWorkflowResourceState(ctx, t)
+	require.False(t, priorState.Set(ctx, &state).HasError())
+	req := resource.UpdateRequest{
+		Config: newWorkflowResourceConfig(ctx, t, state),
+		Plan:   newWorkflowResourcePlan(ctx, t, plan),
+		State:  priorState,
+	}
+	resp := resource.UpdateResponse{State: newWorkflowResourceState(ctx, t)}
+	r.Update(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var saved workflowResourceModel
+	require.False(t, resp.State.Get(ctx, &saved).HasError())
+	assert.Equal(t, types.StringValue("error-workflow"), saved.Settings.ErrorWorkflow)
+}
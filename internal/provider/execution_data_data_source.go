@@ -0,0 +1,163 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &executionDataDataSource{}
+	_ datasource.DataSourceWithConfigure = &executionDataDataSource{}
+)
+
+// NewExecutionDataDataSource returns a new data source.
+func NewExecutionDataDataSource() datasource.DataSource {
+	return &executionDataDataSource{}
+}
+
+type executionDataDataSource struct {
+	client *n8n.Client
+}
+
+type executionDataDataSourceModel struct {
+	ExecutionID types.String `tfsdk:"execution_id"`
+	Extract     types.String `tfsdk:"extract"`
+	WorkflowID  types.String `tfsdk:"workflow_id"`
+	Status      types.String `tfsdk:"status"`
+	Finished    types.Bool   `tfsdk:"finished"`
+	Mode        types.String `tfsdk:"mode"`
+	StartedAt   types.String `tfsdk:"started_at"`
+	StoppedAt   types.String `tfsdk:"stopped_at"`
+	Data        types.String `tfsdk:"data"`
+}
+
+func (d *executionDataDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *executionDataDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution_data"
+}
+
+func (d *executionDataDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an execution along with the data of its node runs. Execution data can weigh several megabytes, so use `extract` to keep only the needed fragment in state and outputs.",
+		Attributes: map[string]schema.Attribute{
+			"execution_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the execution.",
+			},
+			"extract": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON path of the fragment of the execution stored in `data`, such as `$.data.resultData.runData['Send Email']`. The path starts at the execution object, whose `data` field holds the node runs, and is made of `.key`, `['key']` and `[index]` steps. Defaults to the whole execution.",
+			},
+			"workflow_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the executed workflow.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Outcome of the execution, e.g. `success`, `error`, `running` or `waiting`.",
+			},
+			"finished": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the execution completed.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "How the execution was started, e.g. `trigger`, `webhook` or `manual`.",
+			},
+			"started_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the execution started.",
+			},
+			"stopped_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the execution stopped, empty while running.",
+			},
+			"data": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded fragment of the execution selected by `extract`, or the whole execution.",
+			},
+		},
+	}
+}
+
+func (d *executionDataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state executionDataDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var steps []jsonPathStep
+	if !state.Extract.IsNull() {
+		var err error
+		steps, err = parseJSONPath(state.Extract.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("extract"), "Invalid JSON Path", err.Error())
+			return
+		}
+	}
+
+	execution, err := d.client.GetExecution(state.ExecutionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Execution", err.Error())
+		return
+	}
+
+	data, err := extractExecutionData(execution, steps)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("extract"), "Unable to Extract Execution Data", err.Error())
+		return
+	}
+
+	state.WorkflowID = types.StringValue(execution.WorkflowID)
+	state.Status = types.StringValue(execution.Status)
+	state.Finished = types.BoolValue(execution.Finished)
+	state.Mode = types.StringValue(execution.Mode)
+	state.StartedAt = types.StringValue(execution.StartedAt)
+	state.StoppedAt = types.StringValue(execution.StoppedAt)
+	state.Data = types.StringValue(data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// extractExecutionData returns the fragment of an execution selected by the
+// steps of a JSON path, in canonical JSON form.
+func extractExecutionData(execution *n8n.Execution, steps []jsonPathStep) (string, error) {
+	encoded, err := json.Marshal(execution)
+	if err != nil {
+		return "", err
+	}
+	doc, err := decodeJSONFrom(bytes.NewReader(encoded))
+	if err != nil {
+		return "", err
+	}
+
+	fragment, err := extractJSONPath(doc, steps)
+	if err != nil {
+		return "", fmt.Errorf("execution %s has %w", execution.ID, err)
+	}
+	return workflowCanonicalJSON(fragment)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractExecutionData(t *testing.T) {
+	execution := &n8n.Execution{
+		ID:     "42",
+		Status: "success",
+		Data:   json.RawMessage(`{"resultData": {"runData": {"Send Email": [{"data": {"main": [[{"json": {"sent": true}}]]}}], "Webhook": [{}]}}}`),
+	}
+
+	steps, err := parseJSONPath("$.data.resultData.runData['Send Email']")
+	require.NoError(t, err)
+	data, err := extractExecutionData(execution, steps)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"data":{"main":[[{"json":{"sent":true}}]]}}]`, data)
+
+	// Without a path, the whole execution is returned
+	data, err = extractExecutionData(execution, nil)
+	require.NoError(t, err)
+	assert.Contains(t, data, `"status":"success"`)
+	assert.Contains(t, data, `"Webhook":[{}]`)
+
+	steps, err = parseJSONPath("$.data.resultData.runData['Slack']")
+	require.NoError(t, err)
+	_, err = extractExecutionData(execution, steps)
+	assert.EqualError(t, err, `execution 42 has no value at $["data"]["resultData"]["runData"]["Slack"]`)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+)
+
+// isExpression reports whether a parameter value is an n8n expression.
+// n8n marks expressions with a leading "=" and embeds the code in {{ }} blocks.
+func isExpression(value string) bool {
+	return strings.HasPrefix(value, "=") && strings.Contains(value, "{{")
+}
+
+// normalizeExpression returns the canonical form of an n8n expression so that
+// the reformatting n8n applies on save does not show up as drift. Inside every
+// {{ }} block, surrounding whitespace is trimmed, whitespace runs are collapsed
+// and single-quoted string literals are rewritten with double quotes. Text
+// outside the blocks is kept verbatim.
+//
+// For example `={{$json["name"]}}` and `={{ $json['name'] }}` both normalize
+// to `={{ $json["name"] }}`.
+func normalizeExpression(value string) string {
+	if !isExpression(value) {
+		return value
+	}
+
+	var b strings.Builder
+	rest := value
+	for {
+		start := strings.Index(rest, "{{")
+		if start < 0 {
+			b.WriteString(rest)
+			break
+		}
+		end := strings.Index(rest[start+2:], "}}")
+		if end < 0 {
+			b.WriteString(rest)
+			break
+		}
+		end += start + 2
+
+		b.WriteString(rest[:start])
+		b.WriteString("{{ ")
+		b.WriteString(normalizeExpressionCode(rest[start+2 : end]))
+		b.WriteString(" }}")
+
+		rest = rest[end+2:]
+	}
+
+	return b.String()
+}
+
+// normalizeExpressionCode normalizes the JavaScript code of a single {{ }} block.
+func normalizeExpressionCode(code string) string {
+	var b strings.Builder
+	pendingSpace := false
+
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pendingSpace = b.Len() > 0
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			if pendingSpace {
+				b.WriteByte(' ')
+				pendingSpace = false
+			}
+			literal, next := readStringLiteral(code, i)
+			b.WriteString(normalizeStringLiteral(literal))
+			i = next - 1
+			continue
+		}
+
+		if pendingSpace {
+			b.WriteByte(' ')
+			pendingSpace = false
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String()
+}
+
+// readStringLiteral returns the string literal starting at code[start],
+// including its quotes, and the index right after it. Unterminated literals
+// extend to the end of the code.
+func readStringLiteral(code string, start int) (string, int) {
+	quote := code[start]
+	for i := start + 1; i < len(code); i++ {
+		switch code[i] {
+		case '\\':
+			i++
+		case quote:
+			return code[start : i+1], i + 1
+		}
+	}
+	return code[start:], len(code)
+}
+
+// normalizeStringLiteral rewrites a single-quoted literal with double quotes
+// when that does not require changing any escaping. Other literals are
+// returned unchanged.
+func normalizeStringLiteral(literal string) string {
+	if len(literal) < 2 || literal[0] != '\'' || literal[len(literal)-1] != '\'' {
+		return literal
+	}
+
+	body := literal[1 : len(literal)-1]
+	if strings.ContainsAny(body, "\"\\") {
+		return literal
+	}
+
+	return `"` + body + `"`
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeExpression(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain string is not an expression",
+			input:    "hello {{ world }}",
+			expected: "hello {{ world }}",
+		},
+		{
+			name:     "equals sign without braces is not an expression",
+			input:    "=a",
+			expected: "=a",
+		},
+		{
+			name:     "missing padding",
+			input:    "={{$json.x}}",
+			expected: "={{ $json.x }}",
+		},
+		{
+			name:     "extra padding and newlines",
+			input:    "={{  \n $json.x\n }}",
+			expected: "={{ $json.x }}",
+		},
+		{
+			name:     "single quotes become double quotes",
+			input:    "={{ $json['name'] }}",
+			expected: `={{ $json["name"] }}`,
+		},
+		{
+			name:     "single quotes containing double quotes are kept",
+			input:    `={{ 'say "hi"' }}`,
+			expected: `={{ 'say "hi"' }}`,
+		},
+		{
+			name:     "whitespace inside literals is preserved",
+			input:    "={{ $json.a +   ' -  ' +  $json.b }}",
+			expected: `={{ $json.a + " -  " + $json.b }}`,
+		},
+		{
+			name:     "text around blocks is preserved",
+			input:    "=Hello  {{$json.first}}, id {{ $json.id}}!",
+			expected: "=Hello  {{ $json.first }}, id {{ $json.id }}!",
+		},
+		{
+			name:     "unterminated block is kept verbatim",
+			input:    "={{ $json.x ",
+			expected: "={{ $json.x ",
+		},
+		{
+			name:     "template literal is preserved",
+			input:    "={{`a  ${$json.b}`}}",
+			expected: "={{ `a  ${$json.b}` }}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := normalizeExpression(tt.input)
+			if result != tt.expected {
+				t.Errorf("normalizeExpression(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJsonSemanticEqualWithExpressions(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{
+			name:     "server reformatted expression",
+			a:        `[{"id":"set","parameters":{"value":"={{$json.x}}"}}]`,
+			b:        `[{"id":"set","parameters":{"value":"={{ $json.x }}"}}]`,
+			expected: true,
+		},
+		{
+			name:     "quote style difference",
+			a:        `[{"id":"set","parameters":{"value":"={{ $json['x'] }}"}}]`,
+			b:        `[{"id":"set","parameters":{"value":"={{ $json[\"x\"] }}"}}]`,
+			expected: true,
+		},
+		{
+			name:     "different expressions",
+			a:        `[{"id":"set","parameters":{"value":"={{ $json.x }}"}}]`,
+			b:        `[{"id":"set","parameters":{"value":"={{ $json.y }}"}}]`,
+			expected: false,
+		},
+		{
+			name:     "non-expression strings are compared verbatim",
+			a:        `[{"id":"set","parameters":{"value":"{{$json.x}}"}}]`,
+			b:        `[{"id":"set","parameters":{"value":"{{ $json.x }}"}}]`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jsonSemanticEqual(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("jsonSemanticEqual(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
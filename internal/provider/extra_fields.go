@@ -0,0 +1,112 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modeledWorkflowFields lists the workflow fields set through dedicated
+// attributes or managed by n8n, which extra_fields cannot set. The read-only
+// fields n8n returns are among them, as the API rejects updates sending them.
+var modeledWorkflowFields = []string{
+	"active", "connections", "createdAt", "id", "name", "nodes", "settings", "tags", "triggerCount", "updatedAt", "versionId",
+	"homeProject", "isArchived", "meta", "parentFolder", "scopes", "shared", "usedCredentials",
+}
+
+// decodeExtraFields decodes the JSON object of an extra_fields attribute. A
+// null or empty value yields no fields.
+func decodeExtraFields(extraFields types.String) (map[string]json.RawMessage, error) {
+	if extraFields.IsNull() || extraFields.IsUnknown() || extraFields.ValueString() == "" {
+		return nil, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(extraFields.ValueString()), &fields); err != nil {
+		return nil, fmt.Errorf("extra_fields must be a JSON object: %w", err)
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("extra_fields must be a JSON object")
+	}
+	return fields, nil
+}
+
+// extraFieldsFromWorkflow returns the values n8n holds for the fields of a
+// configured extra_fields object, in canonical form. Fields missing from the
+// workflow are left out, so that the next plan sets them again. A null value
+// is returned unchanged, as the workflow fields not set by the configuration
+// are not tracked.
+func extraFieldsFromWorkflow(configured types.String, extra map[string]json.RawMessage) (types.String, error) {
+	fields, err := decodeExtraFields(configured)
+	if err != nil || fields == nil {
+		return configured, err
+	}
+
+	current := make(map[string]json.RawMessage, len(fields))
+	for key := range fields {
+		if value, ok := extra[key]; ok {
+			current[key] = value
+		}
+	}
+
+	encoded, err := workflowCanonicalJSON(current)
+	if err != nil {
+		return configured, err
+	}
+	return types.StringValue(encoded), nil
+}
+
+// setsPinnedData reports whether an extra_fields attribute sets the pinned
+// data of the workflow, which is left out of the workflows read from n8n
+// unless include_pinned_data is set on the provider.
+func setsPinnedData(extraFields types.String) bool {
+	fields, err := decodeExtraFields(extraFields)
+	if err != nil {
+		return false
+	}
+	_, ok := fields["pinData"]
+	return ok
+}
+
+// extraFieldsValidator validates that a string is a JSON object setting none
+// of the modeled workflow fields.
+type extraFieldsValidator struct{}
+
+// ExtraWorkflowFields returns a string validator for the extra_fields attribute.
+func ExtraWorkflowFields() validator.String {
+	return extraFieldsValidator{}
+}
+
+func (v extraFieldsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be a JSON object without the keys %s.", strings.Join(modeledWorkflowFields, ", "))
+}
+
+func (v extraFieldsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v extraFieldsValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	fields, err := decodeExtraFields(req.ConfigValue)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Extra Fields", err.Error())
+		return
+	}
+
+	var modeled []string
+	for _, key := range modeledWorkflowFields {
+		if _, ok := fields[key]; ok {
+			modeled = append(modeled, key)
+		}
+	}
+	if len(modeled) > 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Extra Fields",
+			fmt.Sprintf("extra_fields cannot set %s, which are managed by other attributes or by n8n.", strings.Join(modeled, ", ")))
+	}
+}
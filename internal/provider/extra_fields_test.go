@@ -0,0 +1,77 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeExtraFields(t *testing.T) {
+	fields, err := decodeExtraFields(types.StringValue(`{"description": "Nightly sync"}`))
+	require.NoError(t, err)
+	assert.Equal(t, json.RawMessage(`"Nightly sync"`), fields["description"])
+
+	fields, err = decodeExtraFields(types.StringNull())
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+
+	_, err = decodeExtraFields(types.StringValue(`["description"]`))
+	assert.Error(t, err)
+	_, err = decodeExtraFields(types.StringValue(`null`))
+	assert.Error(t, err)
+}
+
+func TestExtraFieldsFromWorkflow(t *testing.T) {
+	extra := map[string]json.RawMessage{
+		"description": json.RawMessage(`"Edited in n8n"`),
+		"meta":        json.RawMessage(`{"templateId": "42"}`),
+	}
+
+	value, err := extraFieldsFromWorkflow(types.StringValue(`{"description": "Nightly sync", "availableInMCP": true}`), extra)
+	require.NoError(t, err)
+	assert.Equal(t, `{"description":"Edited in n8n"}`, value.ValueString(), "only configured fields are read, missing ones are left out")
+
+	value, err = extraFieldsFromWorkflow(types.StringNull(), extra)
+	require.NoError(t, err)
+	assert.True(t, value.IsNull(), "unconfigured fields are not tracked")
+}
+
+func TestSetsPinnedData(t *testing.T) {
+	assert.True(t, setsPinnedData(types.StringValue(`{"pinData": {"Webhook": [{"json": {}}]}}`)))
+	assert.False(t, setsPinnedData(types.StringValue(`{"description": "Nightly sync"}`)))
+	assert.False(t, setsPinnedData(types.StringNull()))
+	assert.False(t, setsPinnedData(types.StringValue(`[]`)))
+}
+
+func TestExtraWorkflowFieldsValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "unmodeled fields", value: types.StringValue(`{"description": "Nightly sync"}`)},
+		{name: "not an object", value: types.StringValue(`"description"`), wantErr: true},
+		{name: "modeled field", value: types.StringValue(`{"name": "Sync", "nodes": []}`), wantErr: true},
+		{name: "read-only field", value: types.StringValue(`{"shared": []}`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("extra_fields"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			ExtraWorkflowFields().ValidateString(context.Background(), req, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &extractCredentialsFunction{}
+
+// NewExtractCredentialsFunction returns a new function.
+func NewExtractCredentialsFunction() function.Function {
+	return &extractCredentialsFunction{}
+}
+
+type extractCredentialsFunction struct{}
+
+func (f *extractCredentialsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "extract_credentials"
+}
+
+func (f *extractCredentialsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the credentials referenced by workflow nodes",
+		Description: "Parses a JSON-encoded array of workflow nodes and returns the credentials referenced by their credentials blocks, ordered by node name then credential type.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "nodes",
+				Description: "JSON-encoded array of workflow nodes, as in the nodes attribute of n8n_workflow.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: credentialReferenceAttrTypes},
+		},
+	}
+}
+
+func (f *extractCredentialsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var nodesJSON string
+	resp.Error = req.Arguments.Get(ctx, &nodesJSON)
+	if resp.Error != nil {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(nodesJSON), &nodes); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid nodes JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, nodeCredentialReferences(nodes))
+}
@@ -0,0 +1,51 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractCredentialsFunction(t *testing.T) {
+	referenceType := types.ObjectType{AttrTypes: credentialReferenceAttrTypes}
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`[
+			{"name": "Send message", "credentials": {"slackApi": {"id": "12", "name": "Slack bot"}}},
+			{"name": "Wait"}
+		]`)}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(referenceType))}
+
+	(&extractCredentialsFunction{}).Run(context.Background(), req, &resp)
+	require.Nil(t, resp.Error)
+
+	expected := types.ListValueMust(referenceType, []attr.Value{
+		types.ObjectValueMust(credentialReferenceAttrTypes, map[string]attr.Value{
+			"type": types.StringValue("slackApi"),
+			"id":   types.StringValue("12"),
+			"name": types.StringValue("Slack bot"),
+			"node": types.StringValue("Send message"),
+		}),
+	})
+	assert.True(t, resp.Result.Equal(function.NewResultData(expected)), "got %s", resp.Result.Value())
+}
+
+func TestExtractCredentialsFunction_InvalidJSON(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`{`)}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: credentialReferenceAttrTypes}))}
+
+	(&extractCredentialsFunction{}).Run(context.Background(), req, &resp)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Error(), "Invalid nodes JSON")
+}
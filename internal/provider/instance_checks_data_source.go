@@ -0,0 +1,263 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultFailedExecutionsWindow is how far back failed executions are looked
+// for when failed_executions_window is not set.
+const defaultFailedExecutionsWindow = time.Hour
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &instanceChecksDataSource{}
+	_ datasource.DataSourceWithConfigure = &instanceChecksDataSource{}
+)
+
+// NewInstanceChecksDataSource returns a new data source.
+func NewInstanceChecksDataSource() datasource.DataSource {
+	return &instanceChecksDataSource{}
+}
+
+type instanceChecksDataSource struct {
+	client *n8n.Client
+}
+
+type instanceChecksDataSourceModel struct {
+	MinimumVersion         types.String `tfsdk:"minimum_version"`
+	ActiveWorkflowsTag     types.String `tfsdk:"active_workflows_tag"`
+	FailedExecutionsWindow types.String `tfsdk:"failed_executions_window"`
+	Version                types.String `tfsdk:"version"`
+	InactiveWorkflowIDs    types.List   `tfsdk:"inactive_workflow_ids"`
+	FailedExecutionIDs     types.List   `tfsdk:"failed_execution_ids"`
+	Failures               types.List   `tfsdk:"failures"`
+	Passed                 types.Bool   `tfsdk:"passed"`
+}
+
+// instanceCheckResults holds the outcome of the instance checks.
+type instanceCheckResults struct {
+	version             string
+	inactiveWorkflowIDs []string
+	failedExecutionIDs  []string
+	failures            []string
+}
+
+func (d *instanceChecksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *instanceChecksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_checks"
+}
+
+func (d *instanceChecksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks invariants of the n8n instance, for use in `check` blocks run by continuous validation. Each failed check is reported as a warning and listed in `failures`, so that a plan keeps going and an `assert` on `passed` reports the instance as unhealthy.",
+		Attributes: map[string]schema.Attribute{
+			"minimum_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lowest accepted n8n version, such as `1.64.0`. The version is read from the settings served to the n8n editor, as the public API does not expose it. Not checked if unset.",
+			},
+			"active_workflows_tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Tag of the workflows that must all be active, such as the `managed_tag` of the provider. Not checked if unset.",
+			},
+			"failed_executions_window": schema.StringAttribute{
+				Optional:    true,
+				Description: "How far back to look for failed executions, as a Go duration such as `30m`. Defaults to `1h`. Set to `0s` to skip the check.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the n8n instance, only read when `minimum_version` is set.",
+			},
+			"inactive_workflow_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted IDs of the workflows tagged `active_workflows_tag` that are not active.",
+			},
+			"failed_execution_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the executions that failed within `failed_executions_window`, newest first.",
+			},
+			"failures": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Descriptions of the failed checks.",
+			},
+			"passed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether every check passed.",
+			},
+		},
+	}
+}
+
+func (d *instanceChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state instanceChecksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := defaultFailedExecutionsWindow
+	if !state.FailedExecutionsWindow.IsNull() {
+		var err error
+		window, err = time.ParseDuration(state.FailedExecutionsWindow.ValueString())
+		if err != nil || window < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("failed_executions_window"), "Invalid failed_executions_window", fmt.Sprintf("Expected a non-negative Go duration such as \"1h\", got %q.", state.FailedExecutionsWindow.ValueString()))
+			return
+		}
+	}
+
+	results, err := runInstanceChecks(d.client, state.MinimumVersion.ValueString(), state.ActiveWorkflowsTag.ValueString(), window, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Check n8n Instance", err.Error())
+		return
+	}
+
+	for _, failure := range results.failures {
+		resp.Diagnostics.AddWarning("n8n instance check failed", failure)
+	}
+
+	state.Version = types.StringNull()
+	if results.version != "" {
+		state.Version = types.StringValue(results.version)
+	}
+	state.Passed = types.BoolValue(len(results.failures) == 0)
+	state.InactiveWorkflowIDs = stringListValue(results.inactiveWorkflowIDs)
+	state.FailedExecutionIDs = stringListValue(results.failedExecutionIDs)
+	state.Failures = stringListValue(results.failures)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// stringListValue returns a list value of strings, empty rather than null
+// for a nil slice.
+func stringListValue(values []string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// runInstanceChecks checks the version of the instance against
+// minimumVersion, that the workflows tagged activeTag are active, and that no
+// execution failed within window before now. Empty arguments and a zero window
+// skip the corresponding check.
+func runInstanceChecks(client *n8n.Client, minimumVersion, activeTag string, window time.Duration, now time.Time) (instanceCheckResults, error) {
+	var results instanceCheckResults
+
+	if minimumVersion != "" {
+		version, err := client.GetVersion()
+		if err != nil {
+			return results, fmt.Errorf("reading the instance version: %w", err)
+		}
+		results.version = version
+
+		atLeast, err := versionAtLeast(version, minimumVersion)
+		if err != nil {
+			return results, err
+		}
+		if !atLeast {
+			results.failures = append(results.failures, fmt.Sprintf("n8n %s is older than the minimum version %s.", version, minimumVersion))
+		}
+	}
+
+	if activeTag != "" {
+		workflows, err := workflowsWithAllTags(client, []string{activeTag})
+		if err != nil {
+			return results, fmt.Errorf("listing workflows: %w", err)
+		}
+		for _, workflow := range workflows {
+			if !workflow.Active {
+				results.inactiveWorkflowIDs = append(results.inactiveWorkflowIDs, workflow.ID)
+			}
+		}
+		sort.Strings(results.inactiveWorkflowIDs)
+		if len(results.inactiveWorkflowIDs) > 0 {
+			results.failures = append(results.failures, fmt.Sprintf("Workflows tagged %q are not active: %s.", activeTag, strings.Join(results.inactiveWorkflowIDs, ", ")))
+		}
+	}
+
+	if window > 0 {
+		failed, err := client.GetFailedExecutionsSince(now.Add(-window))
+		if err != nil {
+			return results, fmt.Errorf("listing failed executions: %w", err)
+		}
+		for _, execution := range failed {
+			results.failedExecutionIDs = append(results.failedExecutionIDs, execution.ID.String())
+		}
+		if len(failed) > 0 {
+			results.failures = append(results.failures, fmt.Sprintf("%d executions failed in the last %s, the latest of workflow %s.", len(failed), window, failed[0].WorkflowID))
+		}
+	}
+
+	return results, nil
+}
+
+// versionAtLeast reports whether a dotted version such as "1.64.0" is greater
+// than or equal to minimum. Missing components count as zero and pre-release
+// suffixes such as "-rc.1" are ignored.
+func versionAtLeast(version, minimum string) (bool, error) {
+	current, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	wanted, err := parseVersion(minimum)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < len(current) || i < len(wanted); i++ {
+		var a, b int
+		if i < len(current) {
+			a = current[i]
+		}
+		if i < len(wanted) {
+			b = wanted[i]
+		}
+		if a != b {
+			return a > b, nil
+		}
+	}
+	return true, nil
+}
+
+// parseVersion returns the numeric components of a dotted version.
+func parseVersion(version string) ([]int, error) {
+	core, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), "-")
+	var components []int
+	for _, part := range strings.Split(core, ".") {
+		component, err := strconv.Atoi(part)
+		if err != nil || component < 0 {
+			return nil, fmt.Errorf("invalid version %q", version)
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
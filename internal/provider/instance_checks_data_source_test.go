@@ -0,0 +1,66 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunInstanceChecks(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /rest/settings", http.StatusOK, `{"data": {"versionCli": "1.60.1"}}`)
+	server.Respond("GET /api/v1/workflows", http.StatusOK, `{"data": [
+		{"id": "b", "name": "Sync", "active": false, "tags": [{"name": "terraform"}]},
+		{"id": "a", "name": "Report", "active": true, "tags": [{"name": "terraform"}]},
+		{"id": "c", "name": "Draft", "active": false, "tags": []}
+	]}`)
+	server.Respond("GET /api/v1/executions", http.StatusOK, `{"data": [
+		{"id": 7, "workflowId": "a", "status": "error", "startedAt": "2025-01-01T11:40:00.000Z"},
+		{"id": 6, "workflowId": "b", "status": "error", "startedAt": "2025-01-01T09:00:00.000Z"}
+	]}`)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	results, err := runInstanceChecks(server.Client(), "1.64.0", "terraform", time.Hour, now)
+	require.NoError(t, err)
+	assert.Equal(t, "1.60.1", results.version)
+	assert.Equal(t, []string{"b"}, results.inactiveWorkflowIDs)
+	assert.Equal(t, []string{"7"}, results.failedExecutionIDs)
+	require.Len(t, results.failures, 3)
+	assert.Contains(t, results.failures[0], "older than the minimum version 1.64.0")
+
+	// Unset checks send no request
+	results, err = runInstanceChecks(server.Client(), "", "", 0, now)
+	require.NoError(t, err)
+	assert.Empty(t, results.failures)
+	assert.Len(t, server.Requests("GET /rest/settings"), 1)
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		minimum string
+		want    bool
+	}{
+		{"1.64.0", "1.64.0", true},
+		{"1.64.1", "1.64", true},
+		{"1.100.0", "1.64.0", true},
+		{"1.9.0", "1.64.0", false},
+		{"1.64.0-rc.1", "1.64.0", true},
+		{"v2.0.0", "1.64.0", true},
+	}
+	for _, tt := range tests {
+		got, err := versionAtLeast(tt.version, tt.minimum)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "%s >= %s", tt.version, tt.minimum)
+	}
+
+	_, err := versionAtLeast("latest", "1.0.0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &interpolateConnectionsFunction{}
+
+// NewInterpolateConnectionsFunction returns a new function.
+func NewInterpolateConnectionsFunction() function.Function {
+	return &interpolateConnectionsFunction{}
+}
+
+type interpolateConnectionsFunction struct{}
+
+func (f *interpolateConnectionsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interpolate_connections"
+}
+
+func (f *interpolateConnectionsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Renames the nodes referenced by workflow connections",
+		Description: "Returns JSON-encoded workflow connections in canonical form, with the names of the nodes they connect replaced according to a map of renames, both as source keys and as targets. Names missing from the map are kept. Useful to keep connections consistent with nodes whose names are generated, for instance prefixed with the environment name by a module.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "connections",
+				Description: "JSON-encoded workflow connections, as in the connections attribute of n8n_workflow.",
+			},
+			function.MapParameter{
+				Name:        "renames",
+				Description: "Map of the current node names to their new names.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *interpolateConnectionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var connectionsJSON string
+	var renames map[string]string
+	resp.Error = req.Arguments.Get(ctx, &connectionsJSON, &renames)
+	if resp.Error != nil {
+		return
+	}
+
+	renamed, err := renameConnectionNodes(connectionsJSON, renames)
+	if err != nil {
+		var collision *nodeRenameCollisionError
+		if errors.As(err, &collision) {
+			resp.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+		resp.Error = function.NewArgumentFuncError(0, "Invalid connections JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, renamed)
+}
+
+// nodeRenameCollisionError is returned when renames give the same name to
+// several source nodes, whose connections would be merged.
+type nodeRenameCollisionError struct {
+	name          string
+	first, second string
+}
+
+func (e *nodeRenameCollisionError) Error() string {
+	return fmt.Sprintf("nodes %q and %q would both be named %q", e.first, e.second, e.name)
+}
+
+// renameConnectionNodes replaces the names of the nodes connected by
+// JSON-encoded connections according to renames, in the source node keys and
+// in the node field of every connection target.
+func renameConnectionNodes(connectionsJSON string, renames map[string]string) (string, error) {
+	decoded, err := decodeJSON(connectionsJSON)
+	if err != nil {
+		return "", err
+	}
+
+	connections, ok := decoded.(map[string]interface{})
+	if !ok {
+		return "", errors.New("expected an object keyed by source node name")
+	}
+
+	rename := func(name string) string {
+		if renamed, ok := renames[name]; ok {
+			return renamed
+		}
+		return name
+	}
+
+	renamed := make(map[string]interface{}, len(connections))
+	sources := make(map[string]string, len(connections))
+	for source, outputs := range connections {
+		name := rename(source)
+		if other, ok := sources[name]; ok {
+			first, second := other, source
+			if second < first {
+				first, second = second, first
+			}
+			return "", &nodeRenameCollisionError{name: name, first: first, second: second}
+		}
+		sources[name] = source
+
+		// Outputs are keyed by type, each holding a list of targets per
+		// output index
+		if outputs, ok := outputs.(map[string]interface{}); ok {
+			for _, indexes := range outputs {
+				indexes, _ := indexes.([]interface{})
+				for _, targets := range indexes {
+					targets, _ := targets.([]interface{})
+					for _, target := range targets {
+						if target, ok := target.(map[string]interface{}); ok {
+							if node, ok := target["node"].(string); ok {
+								target["node"] = rename(node)
+							}
+						}
+					}
+				}
+			}
+		}
+		renamed[name] = outputs
+	}
+
+	return canonicalJSON(renamed)
+}
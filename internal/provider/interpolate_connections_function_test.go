@@ -0,0 +1,44 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameConnectionNodes(t *testing.T) {
+	connections := `{
+		"Webhook": {"main": [[{"node": "Has items", "type": "main", "index": 0}]]},
+		"Has items": {"main": [[{"node": "Notify", "type": "main", "index": 0}], [{"node": "Log", "type": "main", "index": 0}]]}
+	}`
+
+	renamed, err := renameConnectionNodes(connections, map[string]string{"Webhook": "prod Webhook", "Has items": "prod Has items", "Notify": "prod Notify"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"prod Has items":{"main":[[{"index":0,"node":"prod Notify","type":"main"}],[{"index":0,"node":"Log","type":"main"}]]},"prod Webhook":{"main":[[{"index":0,"node":"prod Has items","type":"main"}]]}}`, renamed)
+
+	_, err = renameConnectionNodes(connections, map[string]string{"Webhook": "Has items"})
+	assert.EqualError(t, err, `nodes "Has items" and "Webhook" would both be named "Has items"`)
+
+	_, err = renameConnectionNodes(`[]`, nil)
+	assert.Error(t, err, "connections must be an object")
+}
+
+func TestInterpolateConnectionsFunction(t *testing.T) {
+	renames := types.MapValueMust(types.StringType, map[string]attr.Value{"Start": types.StringValue("staging Start")})
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`{"Start": {"main": [[{"node": "Start", "type": "main", "index": 0}]]}}`), renames}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	(&interpolateConnectionsFunction{}).Run(context.Background(), req, &resp)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue(`{"staging Start":{"main":[[{"index":0,"node":"staging Start","type":"main"}]]}}`), resp.Result.Value())
+}
@@ -0,0 +1,161 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonDifference describes a single difference between two JSON documents.
+type jsonDifference struct {
+	// Path is the JSON pointer of the differing value.
+	Path string
+
+	// Expected is the canonical JSON of the expected value, empty when the value
+	// only exists in the actual document.
+	Expected string
+
+	// Actual is the canonical JSON of the actual value, empty when the value
+	// only exists in the expected document.
+	Actual string
+}
+
+// diffJSON returns the differences between two JSON documents, after applying
+// the same normalizations as jsonSemanticEqualWithOptions. Array elements with
+// a "name", such as nodes, are matched by name rather than by position.
+func diffJSON(expectedJSON, actualJSON string, opts jsonSemanticOptions) ([]jsonDifference, error) {
+	expected, err := decodeJSON(expectedJSON)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := decodeJSON(actualJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	expected = removeIgnored(expected, opts)
+	actual = removeIgnored(actual, opts)
+
+	var differences []jsonDifference
+	collectJSONDifferences("", normalizeForComparison(expected, opts), normalizeForComparison(actual, opts), &differences)
+	return differences, nil
+}
+
+// collectJSONDifferences appends the differences between two normalized
+// values below pointer to differences.
+func collectJSONDifferences(pointer string, expected, actual interface{}, differences *[]jsonDifference) {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		if a, ok := actual.(map[string]interface{}); ok {
+			keys := unionKeys(e, a)
+			sort.Strings(keys)
+			for _, key := range keys {
+				collectJSONDifferences(pointer+"/"+escapeJSONPointerSegment(key), e[key], a[key], differences)
+			}
+			return
+		}
+	case []interface{}:
+		if a, ok := actual.([]interface{}); ok {
+			collectArrayDifferences(pointer, e, a, differences)
+			return
+		}
+	}
+
+	if expected == nil && actual == nil {
+		return
+	}
+	if expected != nil && actual != nil && renderNormalizedJSON(expected) == renderNormalizedJSON(actual) {
+		return
+	}
+
+	*differences = append(*differences, jsonDifference{
+		Path:     pointer,
+		Expected: renderNormalizedJSON(expected),
+		Actual:   renderNormalizedJSON(actual),
+	})
+}
+
+// collectArrayDifferences compares two normalized arrays. Elements with a name
+// are matched by name, other elements by position.
+func collectArrayDifferences(pointer string, expected, actual []interface{}, differences *[]jsonDifference) {
+	matched := make(map[int]bool, len(actual))
+
+	for i, element := range expected {
+		var counterpart interface{}
+		if name, ok := elementName(element); ok {
+			for j, candidate := range actual {
+				if candidateName, ok := elementName(candidate); ok && candidateName == name && !matched[j] {
+					counterpart = candidate
+					matched[j] = true
+					break
+				}
+			}
+		} else if i < len(actual) {
+			if _, ok := elementName(actual[i]); !ok {
+				counterpart = actual[i]
+				matched[i] = true
+			}
+		}
+		collectJSONDifferences(pointer+"/"+strconv.Itoa(i), element, counterpart, differences)
+	}
+
+	for j, element := range actual {
+		if !matched[j] {
+			collectJSONDifferences(pointer+"/"+strconv.Itoa(j), nil, element, differences)
+		}
+	}
+}
+
+// escapeJSONPointerSegment escapes a key for use in a JSON pointer (RFC 6901).
+func escapeJSONPointerSegment(segment string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(segment)
+}
+
+// renderNormalizedJSON returns the canonical JSON of a value produced by
+// normalizeForComparison, or an empty string for a missing value.
+func renderNormalizedJSON(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	rendered, err := canonicalJSON(denormalizeNumbers(v))
+	if err != nil {
+		return ""
+	}
+	return rendered
+}
+
+// denormalizeNumbers converts the normalizedNumber values of a normalized
+// document back to JSON numbers so that it can be rendered as JSON.
+func denormalizeNumbers(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for key, child := range value {
+			result[key] = denormalizeNumbers(child)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, child := range value {
+			result[i] = denormalizeNumbers(child)
+		}
+		return result
+	case normalizedNumber:
+		rat, ok := new(big.Rat).SetString(string(value))
+		if !ok {
+			return string(value)
+		}
+		if rat.IsInt() {
+			return json.Number(rat.Num().String())
+		}
+		f, _ := rat.Float64()
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+	default:
+		return value
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		opts     jsonSemanticOptions
+		want     []jsonDifference
+	}{
+		{
+			name:     "semantically equal",
+			expected: `[{"name":"A","parameters":{"value":1.0,"url":"={{ $json.url }}"}}]`,
+			actual:   `[{"parameters":{"url":"={{$json.url}}","value":1},"name":"A"}]`,
+		},
+		{
+			name:     "changed value",
+			expected: `[{"name":"A","parameters":{"url":"https://a.example"}}]`,
+			actual:   `[{"name":"A","parameters":{"url":"https://b.example"}}]`,
+			want: []jsonDifference{
+				{Path: "/0/parameters/url", Expected: `"https://a.example"`, Actual: `"https://b.example"`},
+			},
+		},
+		{
+			name:     "nodes matched by name",
+			expected: `[{"name":"A","notes":"a"},{"name":"B","notes":"b"}]`,
+			actual:   `[{"name":"B","notes":"b"},{"name":"A","notes":"a"}]`,
+		},
+		{
+			name:     "missing and extra nodes",
+			expected: `[{"name":"A"},{"name":"B"}]`,
+			actual:   `[{"name":"A"},{"name":"C"}]`,
+			want: []jsonDifference{
+				{Path: "/1", Expected: `{"name":"B"}`},
+				{Path: "/1", Actual: `{"name":"C"}`},
+			},
+		},
+		{
+			name:     "numbers rendered as JSON",
+			expected: `{"timeout":1.5}`,
+			actual:   `{"timeout":3600}`,
+			want: []jsonDifference{
+				{Path: "/timeout", Expected: `1.5`, Actual: `3600`},
+			},
+		},
+		{
+			name:     "keys escaped in pointer",
+			expected: `{"a/b":1}`,
+			actual:   `{"a/b":2}`,
+			want: []jsonDifference{
+				{Path: "/a~1b", Expected: `1`, Actual: `2`},
+			},
+		},
+		{
+			name:     "ignored paths",
+			expected: `[{"name":"A","position":[0,0]}]`,
+			actual:   `[{"name":"A","position":[1,1]}]`,
+			opts:     jsonSemanticOptions{ignorePaths: [][]string{{"*", "position"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffJSON(tt.expected, tt.actual, tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffJSON_InvalidJSON(t *testing.T) {
+	_, err := diffJSON(`[`, `[]`, jsonSemanticOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathStep is a step of a parsed JSON path: an object key or, when index
+// is set, an array index.
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJSONPath parses the subset of JSONPath selecting a single value: the
+// root "$" followed by ".key", "['key']", "[\"key\"]" or "[index]" steps.
+//
+// Example: "$.data.resultData.runData['Send Email'][0]".
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid JSON path %q: must start with \"$\"", path)
+	}
+
+	var steps []jsonPathStep
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			if end == 1 {
+				return nil, fmt.Errorf("invalid JSON path %q: empty key", path)
+			}
+			steps = append(steps, jsonPathStep{key: rest[1:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSON path %q: unterminated bracket", path)
+			}
+			selector := rest[1:end]
+			// Quoted keys may contain a closing bracket
+			if len(selector) > 0 && (selector[0] == '\'' || selector[0] == '"') {
+				closing := strings.Index(rest[2:], string(selector[0])+"]")
+				if closing < 0 {
+					return nil, fmt.Errorf("invalid JSON path %q: unterminated quoted key", path)
+				}
+				steps = append(steps, jsonPathStep{key: rest[2 : closing+2]})
+				rest = rest[closing+4:]
+				continue
+			}
+			index, err := strconv.Atoi(selector)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid JSON path %q: %q is neither a quoted key nor an array index", path, selector)
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSON path %q: unexpected %q", path, rest[0])
+		}
+	}
+	return steps, nil
+}
+
+// extractJSONPath returns the value of a decoded JSON document selected by the
+// steps of a parsed JSON path.
+func extractJSONPath(doc interface{}, steps []jsonPathStep) (interface{}, error) {
+	value := doc
+	for i, step := range steps {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			child, ok := v[step.key]
+			if step.isIndex || !ok {
+				return nil, fmt.Errorf("no value at %s", formatJSONPath(steps[:i+1]))
+			}
+			value = child
+		case []interface{}:
+			if !step.isIndex || step.index >= len(v) {
+				return nil, fmt.Errorf("no value at %s", formatJSONPath(steps[:i+1]))
+			}
+			value = v[step.index]
+		default:
+			return nil, fmt.Errorf("no value at %s", formatJSONPath(steps[:i+1]))
+		}
+	}
+	return value, nil
+}
+
+// formatJSONPath returns the JSON path of parsed steps, quoting every key.
+func formatJSONPath(steps []jsonPathStep) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, step := range steps {
+		if step.isIndex {
+			fmt.Fprintf(&b, "[%d]", step.index)
+		} else {
+			fmt.Fprintf(&b, "[%q]", step.key)
+		}
+	}
+	return b.String()
+}
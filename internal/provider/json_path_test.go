@@ -0,0 +1,54 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	steps, err := parseJSONPath(`$.data.resultData.runData['Send Email'][0]["a.b]"]`)
+	require.NoError(t, err)
+	assert.Equal(t, []jsonPathStep{
+		{key: "data"},
+		{key: "resultData"},
+		{key: "runData"},
+		{key: "Send Email"},
+		{index: 0, isIndex: true},
+		{key: "a.b]"},
+	}, steps)
+
+	steps, err = parseJSONPath("$")
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	for _, invalid := range []string{"data", "$.", "$['unterminated", "$[x]", "$[-1]", "$..data", "$data"} {
+		_, err := parseJSONPath(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestExtractJSONPath(t *testing.T) {
+	doc, err := decodeJSON(`{"data": {"runData": {"Send Email": [{"executionTime": 12}]}}}`)
+	require.NoError(t, err)
+
+	steps, err := parseJSONPath("$.data.runData['Send Email'][0].executionTime")
+	require.NoError(t, err)
+	value, err := extractJSONPath(doc, steps)
+	require.NoError(t, err)
+	assert.Equal(t, "12", value.(interface{ String() string }).String())
+
+	steps, err = parseJSONPath("$.data.runData['Missing'][0]")
+	require.NoError(t, err)
+	_, err = extractJSONPath(doc, steps)
+	assert.EqualError(t, err, `no value at $["data"]["runData"]["Missing"]`)
+
+	steps, err = parseJSONPath("$.data.runData['Send Email'][3]")
+	require.NoError(t, err)
+	_, err = extractJSONPath(doc, steps)
+	assert.Error(t, err)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPointerWildcard matches any object key or array index in a JSON pointer.
+const jsonPointerWildcard = "*"
+
+// parseJSONPointer splits a JSON pointer (RFC 6901) into its unescaped
+// segments. A "*" segment is treated as a wildcard.
+//
+// Example: "/*/parameters/options/timezone" -> ["*", "parameters", "options", "timezone"].
+func parseJSONPointer(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") || len(pointer) < 2 {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with \"/\" and reference a value below the document root", pointer)
+	}
+
+	segments := strings.Split(pointer[1:], "/")
+	for i, segment := range segments {
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+
+	return segments, nil
+}
+
+// parseJSONPointers parses a list of JSON pointers, see parseJSONPointer.
+func parseJSONPointers(pointers []string) ([][]string, error) {
+	parsed := make([][]string, 0, len(pointers))
+	for _, pointer := range pointers {
+		segments, err := parseJSONPointer(pointer)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, segments)
+	}
+	return parsed, nil
+}
+
+// removeJSONPointer removes every value matched by the pointer segments from
+// doc. Objects are modified in place; the possibly new root is returned.
+func removeJSONPointer(doc interface{}, segments []string) interface{} {
+	if len(segments) == 0 {
+		return doc
+	}
+	segment, rest := segments[0], segments[1:]
+
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if segment != jsonPointerWildcard && segment != key {
+				continue
+			}
+			if len(rest) == 0 {
+				delete(v, key)
+			} else {
+				v[key] = removeJSONPointer(child, rest)
+			}
+		}
+		return v
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for i, child := range v {
+			if segment != jsonPointerWildcard && segment != strconv.Itoa(i) {
+				result = append(result, child)
+				continue
+			}
+			if len(rest) > 0 {
+				result = append(result, removeJSONPointer(child, rest))
+			}
+		}
+		return result
+	default:
+		return doc
+	}
+}
+
+// overlayJSONPointer replaces the values matched by the pointer segments in
+// desired with the values found at the same location in current, and removes
+// them from desired when current has none. Array elements that are objects
+// with a "name" (such as workflow nodes) are matched by name rather than by
+// index, so reordering nodes does not move values between them.
+func overlayJSONPointer(desired, current interface{}, segments []string) interface{} {
+	if len(segments) == 0 {
+		return desired
+	}
+	segment, rest := segments[0], segments[1:]
+
+	switch v := desired.(type) {
+	case map[string]interface{}:
+		currentMap, _ := current.(map[string]interface{})
+
+		keys := []string{segment}
+		if segment == jsonPointerWildcard {
+			keys = unionKeys(v, currentMap)
+		}
+
+		for _, key := range keys {
+			currentChild, currentOk := currentMap[key]
+			if len(rest) == 0 {
+				if currentOk {
+					v[key] = currentChild
+				} else {
+					delete(v, key)
+				}
+				continue
+			}
+
+			child, ok := v[key]
+			if !ok {
+				// Create the missing parents of a value that only exists remotely
+				if _, isMap := currentChild.(map[string]interface{}); !currentOk || !isMap {
+					continue
+				}
+				child = map[string]interface{}{}
+			}
+			v[key] = overlayJSONPointer(child, currentChild, rest)
+		}
+		return v
+	case []interface{}:
+		currentSlice, _ := current.([]interface{})
+
+		for i, child := range v {
+			if segment != jsonPointerWildcard && segment != strconv.Itoa(i) {
+				continue
+			}
+			currentChild := matchArrayElement(currentSlice, child, i)
+			if len(rest) == 0 {
+				if currentChild != nil {
+					v[i] = currentChild
+				}
+				continue
+			}
+			v[i] = overlayJSONPointer(child, currentChild, rest)
+		}
+		return v
+	default:
+		return desired
+	}
+}
+
+// overlayJSONPointers applies overlayJSONPointer for every pointer to the JSON
+// documents desiredJSON and currentJSON, returning the resulting JSON.
+func overlayJSONPointers(desiredJSON, currentJSON string, pointers []string) (string, error) {
+	parsed, err := parseJSONPointers(pointers)
+	if err != nil {
+		return "", err
+	}
+	if len(parsed) == 0 {
+		return desiredJSON, nil
+	}
+
+	var desired, current interface{}
+	if err := json.Unmarshal([]byte(desiredJSON), &desired); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal([]byte(currentJSON), &current); err != nil {
+		return "", err
+	}
+
+	for _, segments := range parsed {
+		desired = overlayJSONPointer(desired, current, segments)
+	}
+
+	result, err := json.Marshal(desired)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// matchArrayElement returns the element of current corresponding to the
+// desired element at index i, or nil when there is none.
+func matchArrayElement(current []interface{}, desired interface{}, i int) interface{} {
+	if name, ok := elementName(desired); ok {
+		for _, candidate := range current {
+			if candidateName, ok := elementName(candidate); ok && candidateName == name {
+				return candidate
+			}
+		}
+		return nil
+	}
+
+	if i < len(current) {
+		return current[i]
+	}
+	return nil
+}
+
+// elementName returns the "name" of an object array element, if any.
+func elementName(element interface{}) (string, bool) {
+	obj, ok := element.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	name, ok := obj["name"].(string)
+	return name, ok
+}
+
+// unionKeys returns the keys present in either map.
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,135 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONPointer(t *testing.T) {
+	segments, err := parseJSONPointer("/*/parameters/a~1b/c~0d")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"*", "parameters", "a/b", "c~d"}, segments)
+
+	for _, invalid := range []string{"", "/", "parameters/options"} {
+		_, err := parseJSONPointer(invalid)
+		assert.Error(t, err, "expected %q to be rejected", invalid)
+	}
+}
+
+func TestJsonSemanticEqualWithIgnorePaths(t *testing.T) {
+	ignorePaths, err := parseJSONPointers([]string{"/*/parameters/options/timezone", "/1/position"})
+	require.NoError(t, err)
+	opts := jsonSemanticOptions{ignorePaths: ignorePaths}
+
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{
+			name:     "ignored value differs",
+			a:        `[{"name":"A","parameters":{"options":{"timezone":"UTC","other":1}}}]`,
+			b:        `[{"name":"A","parameters":{"options":{"timezone":"Europe/Berlin","other":1}}}]`,
+			expected: true,
+		},
+		{
+			name:     "ignored value missing on one side",
+			a:        `[{"name":"A","parameters":{"options":{"timezone":"UTC","other":1}}}]`,
+			b:        `[{"name":"A","parameters":{"options":{"other":1}}}]`,
+			expected: true,
+		},
+		{
+			name:     "indexed pointer only applies to that element",
+			a:        `[{"name":"A","position":[0,0]},{"name":"B","position":[1,1]}]`,
+			b:        `[{"name":"A","position":[0,0]},{"name":"B","position":[9,9]}]`,
+			expected: true,
+		},
+		{
+			name:     "non ignored value differs",
+			a:        `[{"name":"A","parameters":{"options":{"other":1}}}]`,
+			b:        `[{"name":"A","parameters":{"options":{"other":2}}}]`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jsonSemanticEqualWithOptions(tt.a, tt.b, opts)
+			if result != tt.expected {
+				t.Errorf("jsonSemanticEqualWithOptions(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOverlayJSONPointers(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  string
+		current  string
+		pointers []string
+		expected string
+	}{
+		{
+			name:     "value taken from server",
+			desired:  `[{"name":"A","parameters":{"options":{"timezone":"UTC","keep":true}}}]`,
+			current:  `[{"name":"A","parameters":{"options":{"timezone":"Europe/Berlin"}}}]`,
+			pointers: []string{"/*/parameters/options/timezone"},
+			expected: `[{"name":"A","parameters":{"options":{"keep":true,"timezone":"Europe/Berlin"}}}]`,
+		},
+		{
+			name:     "value removed when missing on server",
+			desired:  `[{"name":"A","parameters":{"options":{"timezone":"UTC"}}}]`,
+			current:  `[{"name":"A","parameters":{"options":{}}}]`,
+			pointers: []string{"/*/parameters/options/timezone"},
+			expected: `[{"name":"A","parameters":{"options":{}}}]`,
+		},
+		{
+			name:     "missing parents are created",
+			desired:  `[{"name":"A","parameters":{}}]`,
+			current:  `[{"name":"A","parameters":{"options":{"timezone":"UTC"}}}]`,
+			pointers: []string{"/*/parameters/options/timezone"},
+			expected: `[{"name":"A","parameters":{"options":{"timezone":"UTC"}}}]`,
+		},
+		{
+			name:     "nodes are matched by name",
+			desired:  `[{"name":"A","notes":"a"},{"name":"B","notes":"b"}]`,
+			current:  `[{"name":"B","notes":"server b"},{"name":"A","notes":"server a"}]`,
+			pointers: []string{"/*/notes"},
+			expected: `[{"name":"A","notes":"server a"},{"name":"B","notes":"server b"}]`,
+		},
+		{
+			name:     "wildcard keys include server-only entries",
+			desired:  `{"Start":{"main":[]}}`,
+			current:  `{"Start":{"main":[]},"UI Node":{"main":[]}}`,
+			pointers: []string{"/*"},
+			expected: `{"Start":{"main":[]},"UI Node":{"main":[]}}`,
+		},
+		{
+			name:     "no pointers keeps desired untouched",
+			desired:  `[ {"name": "A"} ]`,
+			current:  `[]`,
+			pointers: nil,
+			expected: `[ {"name": "A"} ]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := overlayJSONPointers(tt.desired, tt.current, tt.pointers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestOverlayJSONPointers_InvalidPointer(t *testing.T) {
+	_, err := overlayJSONPointers(`[]`, `[]`, []string{"nodes"})
+	assert.Error(t, err)
+}
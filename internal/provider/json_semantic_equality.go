@@ -4,14 +4,68 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
 	"reflect"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// jsonSemanticOptions controls the opt-in normalizations applied on top of the
+// default ones when comparing JSON semantically.
+type jsonSemanticOptions struct {
+	// ignoreEmptyParameters treats empty strings, objects and arrays inside
+	// node parameters as equivalent to a missing key.
+	ignoreEmptyParameters bool
+
+	// ignorePaths holds the parsed JSON pointers of values excluded from the comparison.
+	ignorePaths [][]string
+
+	// ignoreStickyNotes excludes sticky note nodes from the comparison.
+	ignoreStickyNotes bool
+
+	// inParameters is set while normalizing the contents of a parameters object.
+	inParameters bool
+}
+
+// jsonSemanticOptionsFromConfig reads the comparison options of a resource
+// attribute from the resource configuration. Options missing from the resource
+// schema, or not yet known, keep their default value.
+func jsonSemanticOptionsFromConfig(ctx context.Context, config tfsdk.Config, attribute string) jsonSemanticOptions {
+	var opts jsonSemanticOptions
+
+	var ignoreEmptyParameters types.Bool
+	if diags := config.GetAttribute(ctx, path.Root("ignore_empty_parameters"), &ignoreEmptyParameters); !diags.HasError() {
+		opts.ignoreEmptyParameters = ignoreEmptyParameters.ValueBool()
+	}
+
+	if attribute == "nodes" {
+		var ignoreStickyNotes types.Bool
+		if diags := config.GetAttribute(ctx, path.Root("ignore_sticky_notes"), &ignoreStickyNotes); !diags.HasError() {
+			opts.ignoreStickyNotes = ignoreStickyNotes.ValueBool()
+		}
+	}
+
+	var ignorePaths types.List
+	if diags := config.GetAttribute(ctx, path.Root("ignore_paths").AtName(attribute), &ignorePaths); !diags.HasError() {
+		var pointers []string
+		if diags := ignorePaths.ElementsAs(ctx, &pointers, false); !diags.HasError() {
+			// Invalid pointers are reported by the attribute validator
+			opts.ignorePaths, _ = parseJSONPointers(pointers)
+		}
+	}
+
+	return opts
+}
+
 // jsonSemanticEqualityModifier is a plan modifier that suppresses diffs
 // when two JSON strings are semantically equivalent (same content, different formatting).
 type jsonSemanticEqualityModifier struct{}
@@ -56,7 +110,8 @@ func (m jsonSemanticEqualityModifier) PlanModifyString(ctx context.Context, req
 	}
 
 	// Parse and compare JSON semantically
-	if jsonSemanticEqual(stateJSON, configJSON) {
+	opts := jsonSemanticOptionsFromConfig(ctx, req.Config, req.Path.String())
+	if jsonSemanticEqualWithOptions(stateJSON, configJSON, opts) {
 		// JSON is semantically equal - use state value to suppress diff
 		resp.PlanValue = types.StringValue(stateJSON)
 	}
@@ -65,30 +120,97 @@ func (m jsonSemanticEqualityModifier) PlanModifyString(ctx context.Context, req
 // jsonSemanticEqual compares two JSON strings for semantic equality.
 // Returns true if both strings parse to equivalent JSON structures.
 // It normalizes both structures by removing null values and optional
-// fields that n8n might not return (like executeOnce, alwaysOutputData).
+// fields that n8n might not return (like executeOnce, alwaysOutputData),
+// and by comparing n8n expressions in their canonical form. Nodes compare
+// equal whatever their order in an array of nodes.
 func jsonSemanticEqual(a, b string) bool {
-	var objA, objB interface{}
+	return jsonSemanticEqualWithOptions(a, b, jsonSemanticOptions{})
+}
 
-	if err := json.Unmarshal([]byte(a), &objA); err != nil {
+// jsonSemanticEqualWithOptions compares two JSON strings for semantic equality,
+// applying the opt-in normalizations enabled in opts.
+func jsonSemanticEqualWithOptions(a, b string, opts jsonSemanticOptions) bool {
+	objA, err := decodeJSON(a)
+	if err != nil {
 		return false
 	}
 
-	if err := json.Unmarshal([]byte(b), &objB); err != nil {
+	objB, err := decodeJSON(b)
+	if err != nil {
 		return false
 	}
 
+	// Drop values excluded from the comparison
+	objA = removeIgnored(objA, opts)
+	objB = removeIgnored(objB, opts)
+
+	// n8n may return nodes in a different order than they were submitted
+	objA = sortDecodedNodes(objA)
+	objB = sortDecodedNodes(objB)
+
 	// Normalize both objects to handle n8n API inconsistencies
-	normalizedA := normalizeForComparison(objA)
-	normalizedB := normalizeForComparison(objB)
+	normalizedA := normalizeForComparison(objA, opts)
+	normalizedB := normalizeForComparison(objB, opts)
 
 	return reflect.DeepEqual(normalizedA, normalizedB)
 }
 
+// removeIgnored drops the values excluded from the comparison by opts from a
+// decoded JSON document.
+func removeIgnored(obj interface{}, opts jsonSemanticOptions) interface{} {
+	if opts.ignoreStickyNotes {
+		obj = removeStickyNotes(obj)
+	}
+	for _, segments := range opts.ignorePaths {
+		obj = removeJSONPointer(obj, segments)
+	}
+	return obj
+}
+
+// decodeJSON parses a JSON document keeping numbers as json.Number, so that
+// large integers are not rounded to the nearest float64 before comparison.
+func decodeJSON(input string) (interface{}, error) {
+	return decodeJSONFrom(strings.NewReader(input))
+}
+
+// decodeJSONFrom is decodeJSON reading from r, which avoids copying large
+// documents that are not already held in a string.
+func decodeJSONFrom(r io.Reader) (interface{}, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var obj interface{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+
+	// Reject trailing data the same way json.Unmarshal does
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid character after top-level value")
+	}
+
+	return obj, nil
+}
+
+// normalizedNumber is the canonical representation of a JSON number. It is a
+// distinct type so that the number 1 never compares equal to the string "1".
+type normalizedNumber string
+
+// normalizeNumber converts a JSON number to its exact canonical form, so that
+// 1, 1.0 and 1e0 (or 1e3 and 1000) compare as equal without losing precision.
+func normalizeNumber(n json.Number) normalizedNumber {
+	rat, ok := new(big.Rat).SetString(string(n))
+	if !ok {
+		return normalizedNumber(n)
+	}
+	return normalizedNumber(rat.RatString())
+}
+
 // normalizeForComparison recursively processes JSON data to normalize it for comparison.
 // It removes null values and optional node fields that n8n might not consistently return.
 // For arrays of objects with a "key" field (like parameters), it converts them to maps
 // to make comparison order-independent.
-func normalizeForComparison(data interface{}) interface{} {
+func normalizeForComparison(data interface{}, opts jsonSemanticOptions) interface{} {
 	switch v := data.(type) {
 	case map[string]interface{}:
 		result := make(map[string]interface{})
@@ -99,10 +221,21 @@ func normalizeForComparison(data interface{}) interface{} {
 			}
 			// Skip optional node fields that n8n doesn't consistently return
 			// These fields have default values and may be omitted from API responses
-			if isOptionalNodeField(key) {
+			if isOptionalNodeField(key) || isDefaultNodeFieldValue(key, value) {
 				continue
 			}
-			result[key] = normalizeForComparison(value)
+
+			childOpts := opts
+			if key == "parameters" {
+				childOpts.inParameters = true
+			}
+
+			normalized := normalizeForComparison(value, childOpts)
+			// Inside parameters, empty values are equivalent to missing keys when enabled
+			if opts.inParameters && opts.ignoreEmptyParameters && (normalized == nil || normalized == "") {
+				continue
+			}
+			result[key] = normalized
 		}
 		// Return nil for empty maps to handle {} vs missing field equivalence
 		if len(result) == 0 {
@@ -113,12 +246,12 @@ func normalizeForComparison(data interface{}) interface{} {
 		// Check if this is an array of objects with "key" fields (like parameters)
 		// If so, convert to a map keyed by "key" for order-independent comparison
 		if keyedMap := tryConvertToKeyedMap(v); keyedMap != nil {
-			return normalizeForComparison(keyedMap)
+			return normalizeForComparison(keyedMap, opts)
 		}
 
 		result := make([]interface{}, 0, len(v))
 		for _, item := range v {
-			normalized := normalizeForComparison(item)
+			normalized := normalizeForComparison(item, opts)
 			// Don't add nil items to arrays
 			if normalized != nil {
 				result = append(result, normalized)
@@ -129,6 +262,11 @@ func normalizeForComparison(data interface{}) interface{} {
 			return nil
 		}
 		return result
+	case json.Number:
+		return normalizeNumber(v)
+	case string:
+		// n8n reformats expressions on save, compare their canonical form
+		return normalizeExpression(v)
 	default:
 		return v
 	}
@@ -180,25 +318,47 @@ func isOptionalNodeField(key string) bool {
 		"retryOnFail":      true, // Default: false
 		"onError":          true, // Has default value
 		"continueOnFail":   true, // Default: false
-		"disabled":         true, // Default: false
 	}
 	return optionalFields[key]
 }
 
-// NormalizeJSON takes a JSON string and returns a normalized version
-// with consistent formatting. This can be used to ensure consistent
-// storage in state.
+// isDefaultNodeFieldValue returns true for node fields that are managed by
+// Terraform but that n8n omits when they have their default value, so that
+// the default compares equal to a missing field.
+func isDefaultNodeFieldValue(key string, value interface{}) bool {
+	switch key {
+	case "disabled", "notesInFlow":
+		return value == false
+	case "notes":
+		return value == ""
+	default:
+		return false
+	}
+}
+
+// NormalizeJSON takes a JSON string and returns it in the canonical form used
+// for storage in state: compact (no insignificant whitespace), object keys
+// sorted alphabetically, numbers kept exactly as written and no HTML escaping
+// of <, > and &. Array order is preserved.
 func NormalizeJSON(input string) (string, error) {
-	var obj interface{}
-	if err := json.Unmarshal([]byte(input), &obj); err != nil {
+	obj, err := decodeJSON(input)
+	if err != nil {
 		return "", err
 	}
 
+	return canonicalJSON(obj)
+}
+
+// canonicalJSON marshals a value to the canonical JSON form described in NormalizeJSON.
+func canonicalJSON(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
 	// Marshal with consistent formatting (no indentation, sorted keys for maps)
-	normalized, err := json.Marshal(obj)
-	if err != nil {
+	if err := encoder.Encode(v); err != nil {
 		return "", err
 	}
 
-	return string(normalized), nil
+	return strings.TrimSuffix(buf.String(), "\n"), nil
 }
@@ -204,6 +204,47 @@ func TestNormalizeJSONIdempotent(t *testing.T) {
 	}
 }
 
+func TestNormalizeJSONCanonicalForm(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "keys are sorted and whitespace removed",
+			input:    `{ "name": "Start", "id": "1", "position": [ 0, 0 ] }`,
+			expected: `{"id":"1","name":"Start","position":[0,0]}`,
+		},
+		{
+			name:     "array order is preserved",
+			input:    `[{"b":1},{"a":2}]`,
+			expected: `[{"b":1},{"a":2}]`,
+		},
+		{
+			name:     "large integers keep their precision",
+			input:    `{"id": 9007199254740993}`,
+			expected: `{"id":9007199254740993}`,
+		},
+		{
+			name:     "html characters are not escaped",
+			input:    `{"value": "={{ $json.a > 1 && $json.b < 2 }}"}`,
+			expected: `{"value":"={{ $json.a > 1 && $json.b < 2 }}"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeJSON(tt.input)
+			if err != nil {
+				t.Fatalf("NormalizeJSON(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("NormalizeJSON(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestJsonSemanticEqualWithNullValues(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -278,6 +319,42 @@ func TestJsonSemanticEqualWithOptionalFields(t *testing.T) {
 			b:        `[{"id": "node1"}, {"id": "node2"}]`,
 			expected: true,
 		},
+		{
+			name:     "disabled false vs missing",
+			a:        `{"id": "node1", "disabled": false}`,
+			b:        `{"id": "node1"}`,
+			expected: true,
+		},
+		{
+			name:     "disabled true vs missing",
+			a:        `{"id": "node1", "disabled": true}`,
+			b:        `{"id": "node1"}`,
+			expected: false,
+		},
+		{
+			name:     "disabled true vs false",
+			a:        `[{"id": "node1", "disabled": true}]`,
+			b:        `[{"id": "node1", "disabled": false}]`,
+			expected: false,
+		},
+		{
+			name:     "empty notes vs missing",
+			a:        `{"id": "node1", "notes": "", "notesInFlow": false}`,
+			b:        `{"id": "node1"}`,
+			expected: true,
+		},
+		{
+			name:     "notes vs missing",
+			a:        `{"id": "node1", "notes": "Retries are handled by the caller"}`,
+			b:        `{"id": "node1"}`,
+			expected: false,
+		},
+		{
+			name:     "notesInFlow true vs missing",
+			a:        `{"id": "node1", "notes": "Sync", "notesInFlow": true}`,
+			b:        `{"id": "node1", "notes": "Sync"}`,
+			expected: false,
+		},
 		{
 			name:     "realistic node comparison with optional fields",
 			a:        `[{"id":"get-articles","name":"Get articles","executeOnce":false,"alwaysOutputData":false}]`,
@@ -356,3 +433,131 @@ func TestJsonSemanticEqualWithParameterOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestJsonSemanticEqualWithNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{
+			name:     "integer vs decimal",
+			a:        `{"typeVersion": 1}`,
+			b:        `{"typeVersion": 1.0}`,
+			expected: true,
+		},
+		{
+			name:     "exponent vs integer",
+			a:        `{"value": 1e3}`,
+			b:        `{"value": 1000}`,
+			expected: true,
+		},
+		{
+			name:     "trailing zeros in decimals",
+			a:        `{"typeVersion": 2.10}`,
+			b:        `{"typeVersion": 2.1}`,
+			expected: true,
+		},
+		{
+			name:     "large integers differing beyond float64 precision",
+			a:        `{"id": 9007199254740993}`,
+			b:        `{"id": 9007199254740992}`,
+			expected: false,
+		},
+		{
+			name:     "large identical integers",
+			a:        `{"id": 12345678901234567890}`,
+			b:        `{"id": 12345678901234567890}`,
+			expected: true,
+		},
+		{
+			name:     "number vs numeric string",
+			a:        `{"value": 1}`,
+			b:        `{"value": "1"}`,
+			expected: false,
+		},
+		{
+			name:     "numbers inside position arrays",
+			a:        `[{"position": [240, 300.0]}]`,
+			b:        `[{"position": [2.4e2, 300]}]`,
+			expected: true,
+		},
+		{
+			name:     "trailing data is invalid",
+			a:        `{"value": 1} {}`,
+			b:        `{"value": 1}`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jsonSemanticEqual(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("jsonSemanticEqual(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJsonSemanticEqualWithEmptyParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		opts     jsonSemanticOptions
+		expected bool
+	}{
+		{
+			name:     "empty string parameter vs missing, option disabled",
+			a:        `[{"id":"set","parameters":{"value":"","mode":"manual"}}]`,
+			b:        `[{"id":"set","parameters":{"mode":"manual"}}]`,
+			expected: false,
+		},
+		{
+			name:     "empty string parameter vs missing, option enabled",
+			a:        `[{"id":"set","parameters":{"value":"","mode":"manual"}}]`,
+			b:        `[{"id":"set","parameters":{"mode":"manual"}}]`,
+			opts:     jsonSemanticOptions{ignoreEmptyParameters: true},
+			expected: true,
+		},
+		{
+			name:     "empty object parameter vs missing, option enabled",
+			a:        `[{"id":"if","parameters":{"options":{},"conditions":{"string":[{"value1":"a"}]}}}]`,
+			b:        `[{"id":"if","parameters":{"conditions":{"string":[{"value1":"a"}]}}}]`,
+			opts:     jsonSemanticOptions{ignoreEmptyParameters: true},
+			expected: true,
+		},
+		{
+			name:     "nested empty values inside parameters, option enabled",
+			a:        `[{"id":"if","parameters":{"conditions":{"string":[{"value1":"a","value2":""}]}}}]`,
+			b:        `[{"id":"if","parameters":{"conditions":{"string":[{"value1":"a"}]}}}]`,
+			opts:     jsonSemanticOptions{ignoreEmptyParameters: true},
+			expected: true,
+		},
+		{
+			name:     "empty values outside parameters are still compared",
+			a:        `[{"id":"set","webhookId":"","parameters":{}}]`,
+			b:        `[{"id":"set","parameters":{}}]`,
+			opts:     jsonSemanticOptions{ignoreEmptyParameters: true},
+			expected: false,
+		},
+		{
+			name:     "non-empty parameter differences are still detected",
+			a:        `[{"id":"set","parameters":{"value":"a"}}]`,
+			b:        `[{"id":"set","parameters":{"value":""}}]`,
+			opts:     jsonSemanticOptions{ignoreEmptyParameters: true},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jsonSemanticEqualWithOptions(tt.a, tt.b, tt.opts)
+			if result != tt.expected {
+				t.Errorf("jsonSemanticEqualWithOptions(%q, %q, %+v) = %v, want %v", tt.a, tt.b, tt.opts, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// licenseRequiredSummary is the summary of the diagnostics reported for
+// requests rejected because the n8n instance lacks an Enterprise license.
+const licenseRequiredSummary = "n8n Enterprise License Required"
+
+// addAPIError adds an error for a failed request to n8n. Requests rejected
+// because the instance lacks an Enterprise license, such as those to variables
+// or projects on a community instance, are reported with the feature to
+// license rather than the raw response.
+func addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	if feature, ok := n8n.RequiredLicense(err); ok {
+		diags.AddError(licenseRequiredSummary, licenseRequiredDetail(summary, feature, err))
+		return
+	}
+	diags.AddError(summary, err.Error())
+}
+
+// licenseRequiredDetail describes a request that failed for lack of a
+// license for feature.
+func licenseRequiredDetail(summary, feature string, err error) string {
+	return fmt.Sprintf("%s: the n8n instance is not licensed for %s, which requires an n8n Enterprise license. Activate a license including it, or remove the configuration using it.\n\n%s", summary, feature, err)
+}
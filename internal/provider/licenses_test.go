@@ -0,0 +1,34 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAPIError(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/variables", http.StatusForbidden, `{"message": "Your license does not allow for feat:variables. To enable feat:variables, please upgrade to a license that supports this feature."}`)
+	server.Respond("GET /api/v1/tags", http.StatusForbidden, `{"message": "Forbidden"}`)
+
+	var diags diag.Diagnostics
+	_, err := server.Client().GetVariables()
+	addAPIError(&diags, "Error reading variables", err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, licenseRequiredSummary, diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "Error reading variables: the n8n instance is not licensed for Variables")
+
+	diags = nil
+	_, err = server.Client().GetTags()
+	addAPIError(&diags, "Error reading tags", err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Error reading tags", diags[0].Summary(), "other errors are reported as is")
+	assert.Contains(t, diags[0].Detail(), "tag:list scope")
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// tagWorkflowAsManaged adds the tag with the given name to a workflow,
+// creating the tag first when it does not exist yet. Tags already assigned to
+// the workflow are kept.
+func tagWorkflowAsManaged(client *n8n.Client, workflow *n8n.Workflow, tagName string) error {
+	for _, tag := range workflow.Tags {
+		if tag.Name == tagName {
+			return nil
+		}
+	}
+
+	// The tag ID is cached by the client, so that tagging every workflow of
+	// a plan lists the tags once, and the tag is created once even when
+	// workflows are tagged concurrently
+	tagID, err := client.ResolveOrCreateTagID(tagName)
+	if err != nil {
+		return fmt.Errorf("resolving tag %q: %w", tagName, err)
+	}
+
+	references := make([]n8n.TagReference, 0, len(workflow.Tags)+1)
+	for _, tag := range workflow.Tags {
+		references = append(references, n8n.TagReference{ID: tag.ID})
+	}
+	references = append(references, n8n.TagReference{ID: tagID})
+
+	if _, err := client.UpdateWorkflowTags(workflow.ID, references); err != nil {
+		return fmt.Errorf("assigning tag %q: %w", tagName, err)
+	}
+	return nil
+}
+
+// externalChangeDetail describes a change made to a workflow outside
+// Terraform, detected by comparing the version ID recorded in state with the
+// current one. It returns an empty string when there is nothing to report.
+func externalChangeDetail(stateVersionID string, workflow *n8n.Workflow) string {
+	if stateVersionID == "" || workflow.VersionId == "" || stateVersionID == workflow.VersionId {
+		return ""
+	}
+
+	detail := fmt.Sprintf("Workflow %q (%s) was modified outside Terraform: its version changed from %s to %s", workflow.Name, workflow.ID, stateVersionID, workflow.VersionId)
+	if workflow.UpdatedAt != "" {
+		detail += fmt.Sprintf(", last updated at %s", workflow.UpdatedAt)
+	}
+	return detail + ". Differences with the configuration are shown in the plan and are overwritten on the next apply, unless they are excluded with ignore_paths."
+}
+
+// concurrentModificationDetail explains why an update is refused when the
+// version of a workflow in n8n no longer matches the one in state, which
+// means it was modified after the plan was made. It returns an empty string
+// when the versions match or one of them is unknown.
+func concurrentModificationDetail(stateVersionID string, current *n8n.Workflow) string {
+	if stateVersionID == "" || current.VersionId == "" || stateVersionID == current.VersionId {
+		return ""
+	}
+
+	detail := fmt.Sprintf("Workflow %q (%s) changed from version %s to %s since it was last refreshed", current.Name, current.ID, stateVersionID, current.VersionId)
+	if current.UpdatedAt != "" {
+		detail += fmt.Sprintf(", last updated at %s", current.UpdatedAt)
+	}
+	return detail + ". Applying the plan would overwrite these changes. Run the plan again to review them, or set force = true to overwrite them."
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagWorkflowAsManaged(t *testing.T) {
+	var createdTag bool
+	var assigned string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tags":
+			_, _ = w.Write([]byte(`{"data": [{"id": "1", "name": "production"}], "nextCursor": null}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tags":
+			createdTag = true
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "2", "name": "terraform-managed"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/workflows/wf1/tags":
+			body, _ := io.ReadAll(r.Body)
+			assigned = string(body)
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow := &n8n.Workflow{ID: "wf1", Tags: []n8n.Tag{{ID: "1", Name: "production"}}}
+	require.NoError(t, tagWorkflowAsManaged(client, workflow, "terraform-managed"))
+
+	assert.True(t, createdTag, "missing tag should be created")
+	var references []n8n.TagReference
+	require.NoError(t, json.Unmarshal([]byte(assigned), &references))
+	assert.Equal(t, []n8n.TagReference{{ID: "1"}, {ID: "2"}}, references, "existing tags should be kept")
+}
+
+func TestTagWorkflowAsManaged_AlreadyTagged(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow := &n8n.Workflow{ID: "wf1", Tags: []n8n.Tag{{ID: "2", Name: "terraform-managed"}}}
+	require.NoError(t, tagWorkflowAsManaged(client, workflow, "terraform-managed"))
+}
+
+func TestTagWorkflowAsManaged_Concurrently(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	var mu sync.Mutex
+	created := false
+	server.Handle("GET /api/v1/tags", func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if created {
+			_, _ = w.Write([]byte(`{"data": [{"id": "2", "name": "terraform-managed"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": []}`))
+	})
+	server.Handle("POST /api/v1/tags", func(w http.ResponseWriter, _ *http.Request) {
+		// Leave time for the other workflows to look the tag up meanwhile
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		if created {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message": "Tag already exists"}`))
+			return
+		}
+		created = true
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "2", "name": "terraform-managed"}`))
+	})
+	client := server.Client()
+
+	// Workflows of the same plan are created, and tagged, in parallel
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		id := fmt.Sprintf("wf%d", i)
+		server.Respond("PUT /api/v1/workflows/"+id+"/tags", http.StatusOK, `[]`)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, tagWorkflowAsManaged(client, &n8n.Workflow{ID: id}, "terraform-managed"))
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, server.Requests("POST /api/v1/tags"), 1, "the tag should be created once")
+	for i := 0; i < 10; i++ {
+		requests := server.Requests(fmt.Sprintf("PUT /api/v1/workflows/wf%d/tags", i))
+		require.Len(t, requests, 1)
+		var references []n8n.TagReference
+		requests[0].DecodeBody(t, &references)
+		assert.Equal(t, []n8n.TagReference{{ID: "2"}}, references)
+	}
+}
+
+func TestExternalChangeDetail(t *testing.T) {
+	workflow := &n8n.Workflow{ID: "wf1", Name: "Orders", VersionId: "v2", UpdatedAt: "2025-01-02T00:00:00.000Z"}
+
+	assert.Empty(t, externalChangeDetail("", workflow), "no version in state, e.g. after import")
+	assert.Empty(t, externalChangeDetail("v2", workflow), "unchanged version")
+
+	detail := externalChangeDetail("v1", workflow)
+	assert.Contains(t, detail, "from v1 to v2")
+	assert.Contains(t, detail, "2025-01-02T00:00:00.000Z")
+}
+
+func TestConcurrentModificationDetail(t *testing.T) {
+	current := &n8n.Workflow{ID: "wf1", Name: "Orders", VersionId: "v2", UpdatedAt: "2025-01-02T00:00:00.000Z"}
+
+	assert.Empty(t, concurrentModificationDetail("", current), "no version in state, e.g. after import")
+	assert.Empty(t, concurrentModificationDetail("v2", current), "unchanged since the last refresh")
+	assert.Empty(t, concurrentModificationDetail("v1", &n8n.Workflow{ID: "wf1"}), "instance without versions")
+
+	detail := concurrentModificationDetail("v1", current)
+	assert.Contains(t, detail, "from version v1 to v2")
+	assert.Contains(t, detail, "2025-01-02T00:00:00.000Z")
+	assert.Contains(t, detail, "force = true")
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer(t)
+	server.Respond("POST /api/v1/workflows", http.StatusOK, `{"id": "wf1", "name": "Orders"}`)
+
+	workflow := Workflow("", "Orders", Webhook("Webhook", "orders", "POST"), ManualTrigger("Manual"))
+	created, err := server.Client().CreateWorkflow(&n8n.CreateWorkflowRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "wf1", created.ID)
+
+	requests := server.Requests("POST /api/v1/workflows")
+	require.Len(t, requests, 1)
+	assert.Equal(t, "test-token", requests[0].Header.Get("X-N8N-API-KEY"))
+
+	var body n8n.CreateWorkflowRequest
+	requests[0].DecodeBody(t, &body)
+	assert.Equal(t, "Orders", body.Name)
+	assert.Len(t, body.Nodes, 2)
+	assert.JSONEq(t, `[[{"node":"Manual","type":"main","index":0}]]`, string(body.Connections["Webhook"]["main"]))
+}
+
+func TestBuilders(t *testing.T) {
+	schedule := Schedule("Nightly", "0 2 * * *")
+	assert.Equal(t, "n8n-nodes-base.scheduleTrigger", schedule.Type)
+	assert.JSONEq(t, `{"rule":{"interval":[{"field":"cronExpression","expression":"0 2 * * *"}]}}`, JSON(t, schedule.Parameters))
+
+	assert.Empty(t, Connections("Only"))
+	assert.Len(t, Connections("A", "B", "C"), 2)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+// Package n8ntest provides a fake n8n API and workflow builders for provider
+// tests, so that tests only declare the requests they expect and the
+// workflows they work with.
+package n8ntest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// Server is a fake n8n API answering the routes registered by the test. Any
+// other request fails the test. Every request is recorded.
+type Server struct {
+	*httptest.Server
+
+	t        testing.TB
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []Request
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	// Route is the method and path of the request, e.g. "GET /api/v1/workflows".
+	Route string
+
+	// Header holds the request headers.
+	Header http.Header
+
+	// Body is the raw request body.
+	Body []byte
+}
+
+// NewServer starts a Server, closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{t: t, routes: make(map[string]http.HandlerFunc)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Handle registers the handler of a route, given as method and path such as
+// "POST /api/v1/workflows". Query strings are not part of the route.
+func (s *Server) Handle(route string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[route] = handler
+}
+
+// Respond registers a canned response for a route. An empty body sends no
+// content.
+func (s *Server) Respond(route string, status int, body string) {
+	s.Handle(route, func(w http.ResponseWriter, _ *http.Request) {
+		if body != "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	})
+}
+
+// Client returns an API client for the server.
+func (s *Server) Client() *n8n.Client {
+	s.t.Helper()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&s.URL, &token)
+	if err != nil {
+		s.t.Fatalf("creating client: %v", err)
+	}
+	return client
+}
+
+// Requests returns the requests received on a route, in order.
+func (s *Server) Requests(route string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []Request
+	for _, request := range s.requests {
+		if request.Route == route {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// DecodeBody decodes the JSON body of a request into out, failing the test
+// if it is not valid.
+func (r Request) DecodeBody(t testing.TB, out interface{}) {
+	t.Helper()
+
+	if err := json.Unmarshal(r.Body, out); err != nil {
+		t.Fatalf("decoding body of %s: %v", r.Route, err)
+	}
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	route := r.Method + " " + r.URL.Path
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Route: route, Header: r.Header.Clone(), Body: body})
+	handler, ok := s.routes[route]
+	s.mu.Unlock()
+
+	if !ok {
+		s.t.Errorf("unexpected request %s", route)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	handler(w, r)
+}
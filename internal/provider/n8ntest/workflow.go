@@ -0,0 +1,87 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// Node returns a node with the given name, type and parameters. Its ID is
+// derived from the name.
+func Node(name, nodeType string, parameters map[string]interface{}) n8n.Node {
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+	return n8n.Node{
+		ID:          "id-" + name,
+		Name:        name,
+		Type:        nodeType,
+		TypeVersion: 1,
+		Position:    []int{0, 0},
+		Parameters:  parameters,
+	}
+}
+
+// ManualTrigger returns a Manual Trigger node.
+func ManualTrigger(name string) n8n.Node {
+	return Node(name, "n8n-nodes-base.manualTrigger", nil)
+}
+
+// Webhook returns a Webhook node listening on path for the given HTTP method.
+func Webhook(name, path, method string) n8n.Node {
+	return Node(name, "n8n-nodes-base.webhook", map[string]interface{}{"path": path, "httpMethod": method})
+}
+
+// Schedule returns a Schedule Trigger node firing on a cron expression.
+func Schedule(name, expression string) n8n.Node {
+	return Node(name, "n8n-nodes-base.scheduleTrigger", map[string]interface{}{
+		"rule": map[string]interface{}{
+			"interval": []interface{}{
+				map[string]interface{}{"field": "cronExpression", "expression": expression},
+			},
+		},
+	})
+}
+
+// Connections returns the connections of a chain of nodes, each connected to
+// the next one through their first main output and input.
+func Connections(names ...string) map[string]n8n.Connection {
+	connections := make(map[string]n8n.Connection)
+	for i := 0; i+1 < len(names); i++ {
+		main := fmt.Sprintf(`[[{"node":%q,"type":"main","index":0}]]`, names[i+1])
+		connections[names[i]] = n8n.Connection{"main": json.RawMessage(main)}
+	}
+	return connections
+}
+
+// Workflow returns a workflow with the given nodes connected in order.
+func Workflow(id, name string, nodes ...n8n.Node) n8n.Workflow {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Name
+	}
+	return n8n.Workflow{
+		ID:          id,
+		Name:        name,
+		Nodes:       nodes,
+		Connections: Connections(names...),
+	}
+}
+
+// JSON returns the JSON encoding of a value, such as the nodes or connections
+// of a workflow set as resource attributes, failing the test if it cannot be
+// encoded.
+func JSON(t testing.TB, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("encoding %T: %v", v, err)
+	}
+	return string(data)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// maxReportedNodes limits the candidate nodes listed in an error detail.
+const maxReportedNodes = 5
+
+var (
+	// nodeIndexPattern matches node indexes in request validation errors,
+	// such as "request/body/nodes/3/parameters" or "nodes[3].type".
+	nodeIndexPattern = regexp.MustCompile(`nodes(?:/|\[|\.)(\d+)`)
+
+	// parameterKeyPattern matches parameter names followed by an index or
+	// key, such as "propertyValues" in "propertyValues[itemName] is not
+	// iterable".
+	parameterKeyPattern = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\[`)
+)
+
+// workflowWriteError returns the message of an error returned by the API for
+// a workflow write, followed by the nodes the error most likely concerns so
+// that they can be found among the many nodes of a large workflow.
+func workflowWriteError(err error, nodes []n8n.Node) string {
+	message := err.Error()
+
+	indexes := offendingNodeIndexes(message, nodes)
+	if len(indexes) == 0 {
+		return message
+	}
+
+	var descriptions []string
+	for _, i := range indexes {
+		if len(descriptions) == maxReportedNodes {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(indexes)-maxReportedNodes))
+			break
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%q (%s, index %d)", nodes[i].Name, nodes[i].Type, i))
+	}
+
+	if len(indexes) == 1 {
+		return fmt.Sprintf("%s\n\nThe error likely concerns node %s.", message, descriptions[0])
+	}
+	return fmt.Sprintf("%s\n\nThe error may concern nodes %s.", message, strings.Join(descriptions, ", "))
+}
+
+// offendingNodeIndexes returns the sorted indexes of the nodes an API error
+// message refers to, either by index, name or type, or through the name of a
+// parameter only some nodes have. The most specific match found is used.
+func offendingNodeIndexes(message string, nodes []n8n.Node) []int {
+	matched := make(map[int]bool)
+
+	for _, match := range nodeIndexPattern.FindAllStringSubmatch(message, -1) {
+		if i, err := strconv.Atoi(match[1]); err == nil && i < len(nodes) {
+			matched[i] = true
+		}
+	}
+
+	if len(matched) == 0 {
+		for i, node := range nodes {
+			// Names may be quoted within a JSON-encoded response body
+			quotedName := strings.Contains(message, `"`+node.Name+`"`) || strings.Contains(message, `\"`+node.Name+`\"`) || strings.Contains(message, `'`+node.Name+`'`)
+			if (node.Name != "" && quotedName) || (node.Type != "" && strings.Contains(message, node.Type)) {
+				matched[i] = true
+			}
+		}
+	}
+
+	if len(matched) == 0 {
+		for _, match := range parameterKeyPattern.FindAllStringSubmatch(message, -1) {
+			for i, node := range nodes {
+				if hasParameterKey(node.Parameters, match[1]) {
+					matched[i] = true
+				}
+			}
+		}
+	}
+
+	indexes := make([]int, 0, len(matched))
+	for i := range matched {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// hasParameterKey reports whether key is the name of a parameter at any
+// depth of the given node parameters.
+func hasParameterKey(value interface{}, key string) bool {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for k, nested := range value {
+			if k == key || hasParameterKey(nested, key) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, nested := range value {
+			if hasParameterKey(nested, key) {
+				return true
+			}
+		}
+	}
+	return false
+}
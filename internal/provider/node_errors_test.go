@@ -0,0 +1,52 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffendingNodeIndexes(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Webhook", Type: "n8n-nodes-base.webhook"},
+		{Name: "Set fields", Type: "n8n-nodes-base.set", Parameters: map[string]interface{}{
+			"values": map[string]interface{}{"propertyValues": []interface{}{}},
+		}},
+		{Name: "Custom", Type: "n8n-nodes-community.unknown"},
+		{Name: "Respond", Type: "n8n-nodes-base.respondToWebhook"},
+	}
+
+	tests := []struct {
+		message  string
+		expected []int
+	}{
+		{`status: 400, body: {"message":"request/body/nodes/3/position must be array"}`, []int{3}},
+		{`status: 400, body: {"message":"nodes[1].type must be string"}`, []int{1}},
+		{`status: 400, body: {"message":"Unrecognized node type: n8n-nodes-community.unknown"}`, []int{2}},
+		{`status: 400, body: {"message":"Node \"Respond\" has issues"}`, []int{3}},
+		{`status: 400, body: {"message":"propertyValues[itemName] is not iterable"}`, []int{1}},
+		{`status: 400, body: {"message":"request/body/nodes/9/type is required"}`, []int{}},
+		{`status: 500, body: {"message":"Internal error"}`, []int{}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, offendingNodeIndexes(tt.message, nodes), tt.message)
+	}
+}
+
+func TestWorkflowWriteError(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Webhook", Type: "n8n-nodes-base.webhook"},
+		{Name: "Custom", Type: "n8n-nodes-community.unknown"},
+	}
+
+	message := workflowWriteError(errors.New("Unrecognized node type: n8n-nodes-community.unknown"), nodes)
+	assert.Contains(t, message, `The error likely concerns node "Custom" (n8n-nodes-community.unknown, index 1).`)
+
+	assert.Equal(t, "Internal error", workflowWriteError(errors.New("Internal error"), nodes))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// sortNodes returns a copy of nodes ordered by ID, then by name for nodes
+// without an ID. n8n does not always return nodes in the order they were
+// submitted, so nodes are stored in state in this order.
+func sortNodes(nodes []n8n.Node) []n8n.Node {
+	if nodes == nil {
+		return nil
+	}
+
+	sorted := make([]n8n.Node, len(nodes))
+	copy(sorted, nodes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return nodeLess(sorted[i].ID, sorted[i].Name, sorted[j].ID, sorted[j].Name)
+	})
+	return sorted
+}
+
+// sortDecodedNodes returns a decoded array of nodes ordered like sortNodes, so
+// that nodes compare equal whatever their position in the array. Values that
+// are not an array of nodes, i.e. objects with a type and a name, are returned
+// unchanged.
+func sortDecodedNodes(v interface{}) interface{} {
+	items, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+
+	nodes := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return v
+		}
+		if _, ok := fields["type"].(string); !ok {
+			return v
+		}
+		if _, ok := fields["name"].(string); !ok {
+			return v
+		}
+		nodes = append(nodes, fields)
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		idI, _ := nodes[i]["id"].(string)
+		idJ, _ := nodes[j]["id"].(string)
+		return nodeLess(idI, nodes[i]["name"].(string), idJ, nodes[j]["name"].(string))
+	})
+
+	result := make([]interface{}, len(nodes))
+	for i, node := range nodes {
+		result[i] = node
+	}
+	return result
+}
+
+// nodeLess orders nodes by ID, then by name.
+func nodeLess(idA, nameA, idB, nameB string) bool {
+	if idA != idB {
+		return idA < idB
+	}
+	return nameA < nameB
+}
@@ -0,0 +1,49 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortNodes(t *testing.T) {
+	nodes := []n8n.Node{
+		{ID: "b", Name: "Second"},
+		{Name: "Unsaved"},
+		{ID: "a", Name: "First"},
+	}
+
+	sorted := sortNodes(nodes)
+	assert.Equal(t, []n8n.Node{{Name: "Unsaved"}, {ID: "a", Name: "First"}, {ID: "b", Name: "Second"}}, sorted)
+	assert.Equal(t, "b", nodes[0].ID, "the input is left unchanged")
+	assert.Nil(t, sortNodes(nil))
+}
+
+func TestNodeOrderComparison(t *testing.T) {
+	submitted := `[
+		{"id": "1", "name": "Webhook", "type": "n8n-nodes-base.webhook"},
+		{"id": "2", "name": "Respond", "type": "n8n-nodes-base.respondToWebhook"}
+	]`
+	returned := `[
+		{"id": "2", "name": "Respond", "type": "n8n-nodes-base.respondToWebhook"},
+		{"id": "1", "name": "Webhook", "type": "n8n-nodes-base.webhook"}
+	]`
+	assert.True(t, jsonSemanticEqual(submitted, returned))
+
+	// Nodes without IDs are ordered by name
+	assert.True(t, jsonSemanticEqual(
+		`[{"name": "A", "type": "t"}, {"name": "B", "type": "t"}]`,
+		`[{"name": "B", "type": "t"}, {"name": "A", "type": "t"}]`,
+	))
+
+	// Other arrays keep their order
+	assert.False(t, jsonSemanticEqual(`[{"name": "A"}, {"name": "B"}]`, `[{"name": "B"}, {"name": "A"}]`))
+	assert.False(t, jsonSemanticEqual(
+		`{"values": [{"name": "A", "type": "t"}, {"name": "B", "type": "t"}]}`,
+		`{"values": [{"name": "B", "type": "t"}, {"name": "A", "type": "t"}]}`,
+	))
+}
@@ -0,0 +1,145 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// nodeRename describes a node whose name changes while its ID stays the same.
+type nodeRename struct {
+	from string
+	to   string
+}
+
+// nodeRenames returns the nodes of the state renamed in the plan, matched by
+// ID and sorted by their current name. Nodes without an ID cannot be told
+// apart from a removed node and an added one, and invalid JSON yields no
+// renames.
+func nodeRenames(stateNodesJSON, planNodesJSON string) []nodeRename {
+	type namedNode struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	var stateNodes, planNodes []namedNode
+	if err := json.Unmarshal([]byte(stateNodesJSON), &stateNodes); err != nil {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(planNodesJSON), &planNodes); err != nil {
+		return nil
+	}
+
+	current := make(map[string]string, len(stateNodes))
+	for _, node := range stateNodes {
+		if node.ID != "" {
+			current[node.ID] = node.Name
+		}
+	}
+
+	var renames []nodeRename
+	for _, node := range planNodes {
+		if name, ok := current[node.ID]; ok && node.ID != "" && name != node.Name {
+			renames = append(renames, nodeRename{from: name, to: node.Name})
+		}
+	}
+	sort.Slice(renames, func(i, j int) bool { return renames[i].from < renames[j].from })
+	return renames
+}
+
+// connectedNodeNames returns the names of the nodes a JSON-encoded connections
+// object refers to, either as the source of connections or as their target.
+func connectedNodeNames(connectionsJSON string) map[string]bool {
+	decoded, err := decodeJSON(connectionsJSON)
+	if err != nil {
+		return nil
+	}
+	sources, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	var collect func(v interface{})
+	collect = func(v interface{}) {
+		switch value := v.(type) {
+		case map[string]interface{}:
+			if node, ok := value["node"].(string); ok {
+				names[node] = true
+			}
+			for _, child := range value {
+				collect(child)
+			}
+		case []interface{}:
+			for _, child := range value {
+				collect(child)
+			}
+		}
+	}
+	for source, outputs := range sources {
+		names[source] = true
+		collect(outputs)
+	}
+	return names
+}
+
+// warnAboutNodeRenames reports the nodes renamed by a plan. A rename changes
+// the keys of the connections object along with the node name, which the plan
+// shows as unrelated changes to nodes and connections. Connections still
+// referring to the previous name of a node are reported as broken.
+func warnAboutNodeRenames(plan, state workflowResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.Nodes.IsUnknown() || plan.Nodes.IsNull() || state.Nodes.IsNull() {
+		return
+	}
+	renames := nodeRenames(state.Nodes.ValueString(), plan.Nodes.ValueString())
+	if len(renames) == 0 {
+		return
+	}
+
+	var lines []string
+	for _, rename := range renames {
+		lines = append(lines, fmt.Sprintf("- %q is renamed to %q", rename.from, rename.to))
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("nodes"),
+		"Workflow nodes renamed",
+		fmt.Sprintf("The plan renames nodes of workflow %s, which also changes the keys of its connections:\n%s\n\n"+
+			"Nodes and connections are written in a single request, so the nodes are renamed and their connections moved at the same time.",
+			state.ID.ValueString(), strings.Join(lines, "\n")),
+	)
+
+	if plan.Connections.IsUnknown() || plan.Connections.IsNull() {
+		return
+	}
+	var planNodes []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &planNodes); err != nil {
+		return
+	}
+	connected := connectedNodeNames(plan.Connections.ValueString())
+	for _, node := range planNodes {
+		delete(connected, node.Name)
+	}
+
+	var stale []string
+	for _, rename := range renames {
+		if connected[rename.from] {
+			stale = append(stale, fmt.Sprintf("%q", rename.from))
+		}
+	}
+	if len(stale) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("connections"),
+			"Connections refer to renamed nodes",
+			fmt.Sprintf("The connections of workflow %s still refer to %s by the previous name, so they will not connect anything once the nodes are renamed. Use the new node names in connections.",
+				state.ID.ValueString(), strings.Join(stale, ", ")),
+		)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeRenames(t *testing.T) {
+	state := `[{"id":"1","name":"Webhook"},{"id":"2","name":"Set"},{"name":"Unsaved"}]`
+	plan := `[{"id":"1","name":"Incoming request"},{"id":"2","name":"Set"},{"name":"Renamed"},{"id":"3","name":"Added"}]`
+
+	assert.Equal(t, []nodeRename{{from: "Webhook", to: "Incoming request"}}, nodeRenames(state, plan))
+	assert.Empty(t, nodeRenames(state, state))
+	assert.Empty(t, nodeRenames(state, `not json`))
+}
+
+func TestConnectedNodeNames(t *testing.T) {
+	connections := `{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]},"Agent":{"ai_tool":[[{"node":"Tool","type":"ai_tool","index":0}]]}}`
+	assert.Equal(t, map[string]bool{"Webhook": true, "Set": true, "Agent": true, "Tool": true}, connectedNodeNames(connections))
+	assert.Nil(t, connectedNodeNames(`[]`))
+}
+
+func TestWarnAboutNodeRenames(t *testing.T) {
+	state := testWorkflowResourceModel()
+	state.Nodes = types.StringValue(`[{"id":"1","name":"Webhook"},{"id":"2","name":"Set"}]`)
+	state.Connections = types.StringValue(`{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]}}`)
+
+	tests := []struct {
+		name        string
+		nodes       string
+		connections string
+		warnings    []string
+	}{
+		{
+			name:        "no rename",
+			nodes:       `[{"id":"1","name":"Webhook"},{"id":"2","name":"Set"}]`,
+			connections: `{}`,
+		},
+		{
+			name:        "rename with connections",
+			nodes:       `[{"id":"1","name":"Incoming"},{"id":"2","name":"Set"}]`,
+			connections: `{"Incoming":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			warnings:    []string{"Workflow nodes renamed"},
+		},
+		{
+			name:        "rename leaving connections behind",
+			nodes:       `[{"id":"1","name":"Webhook"},{"id":"2","name":"Fields"}]`,
+			connections: `{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			warnings:    []string{"Workflow nodes renamed", "Connections refer to renamed nodes"},
+		},
+		{
+			name:        "previous name reused by another node",
+			nodes:       `[{"id":"1","name":"Incoming"},{"id":"3","name":"Webhook"},{"id":"2","name":"Set"}]`,
+			connections: `{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			warnings:    []string{"Workflow nodes renamed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			plan.Nodes = types.StringValue(tt.nodes)
+			plan.Connections = types.StringValue(tt.connections)
+
+			var resp resource.ModifyPlanResponse
+			warnAboutNodeRenames(plan, state, &resp)
+			require.False(t, resp.Diagnostics.HasError())
+
+			var summaries []string
+			for _, d := range resp.Diagnostics.Warnings() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, tt.warnings, summaries)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// disallowedNodes returns a description of each node whose type is listed in
+// disallowed, in the order of the nodes.
+func disallowedNodes(nodes []n8n.Node, disallowed []string) []string {
+	var matched []string
+	for _, node := range nodes {
+		for _, nodeType := range disallowed {
+			if node.Type == nodeType {
+				matched = append(matched, fmt.Sprintf("%q (%s)", node.Name, node.Type))
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// refuseDisallowedNodeTypes fails the plan of a workflow containing nodes of a
+// type listed in the provider disallowed_node_types. Every plan is checked,
+// not only the changed content, so that workflows managed before a type was
+// disallowed are reported too. Read-only workflows are never written and are
+// not checked.
+func (r *workflowResource) refuseDisallowedNodeTypes(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	if len(r.disallowedNodeTypes) == 0 {
+		return
+	}
+
+	var plan workflowResourceModel
+	if diags := resp.Plan.Get(ctx, &plan); diags.HasError() || plan.ReadOnly.ValueBool() || plan.Nodes.IsUnknown() || plan.Nodes.IsNull() {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	for _, node := range disallowedNodes(nodes, r.disallowedNodeTypes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("nodes"),
+			"Disallowed node type",
+			fmt.Sprintf("Workflow %q contains the node %s, whose type is listed in the provider disallowed_node_types. Remove the node from the workflow.", plan.Name.ValueString(), node),
+		)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisallowedNodes(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Webhook", Type: webhookNodeType},
+		{Name: "Run script", Type: "n8n-nodes-base.executeCommand"},
+		{Name: "Transform", Type: "n8n-nodes-base.code"},
+	}
+
+	assert.Equal(t, []string{
+		`"Run script" (n8n-nodes-base.executeCommand)`,
+		`"Transform" (n8n-nodes-base.code)`,
+	}, disallowedNodes(nodes, []string{"n8n-nodes-base.code", "n8n-nodes-base.executeCommand"}))
+	assert.Empty(t, disallowedNodes(nodes, nil))
+}
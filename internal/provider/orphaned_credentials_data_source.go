@@ -0,0 +1,126 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &orphanedCredentialsDataSource{}
+	_ datasource.DataSourceWithConfigure = &orphanedCredentialsDataSource{}
+)
+
+// NewOrphanedCredentialsDataSource returns a new data source.
+func NewOrphanedCredentialsDataSource() datasource.DataSource {
+	return &orphanedCredentialsDataSource{}
+}
+
+type orphanedCredentialsDataSource struct {
+	client *n8n.Client
+}
+
+type orphanedCredentialsDataSourceModel struct {
+	CredentialIDs types.Set  `tfsdk:"credential_ids"`
+	OrphanedIDs   types.List `tfsdk:"orphaned_ids"`
+}
+
+func (d *orphanedCredentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *orphanedCredentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orphaned_credentials"
+}
+
+func (d *orphanedCredentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the credentials that no workflow of the instance references, among the given ones. The n8n public API cannot list credentials, so the candidate IDs must be provided, for example from the credentials managed in the same configuration.",
+		Attributes: map[string]schema.Attribute{
+			"credential_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the credentials to check.",
+			},
+			"orphaned_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted IDs of the credentials not referenced by any workflow node.",
+			},
+		},
+	}
+}
+
+func (d *orphanedCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state orphanedCredentialsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var credentialIDs []string
+	resp.Diagnostics.Append(state.CredentialIDs.ElementsAs(ctx, &credentialIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the referenced credential IDs are kept while workflows are listed
+	// page by page, not the workflows themselves
+	referenced := make(map[string]bool)
+	err := d.client.ForEachWorkflow(ctx, nil, func(workflow n8n.Workflow) error {
+		addCredentialReferences(referenced, workflow)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	state.OrphanedIDs, diags = types.ListValueFrom(ctx, types.StringType, orphanedCredentialIDs(credentialIDs, referenced))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// addCredentialReferences adds the IDs of the credentials referenced by the
+// nodes of a workflow to referenced.
+func addCredentialReferences(referenced map[string]bool, workflow n8n.Workflow) {
+	for _, reference := range nodeCredentialReferences(workflow.Nodes) {
+		referenced[reference.ID] = true
+	}
+}
+
+// orphanedCredentialIDs returns the sorted credential IDs missing from
+// referenced.
+func orphanedCredentialIDs(credentialIDs []string, referenced map[string]bool) []string {
+	orphaned := []string{}
+	for _, id := range credentialIDs {
+		if !referenced[id] {
+			orphaned = append(orphaned, id)
+		}
+	}
+	sort.Strings(orphaned)
+	return orphaned
+}
@@ -0,0 +1,26 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrphanedCredentialIDs(t *testing.T) {
+	workflows := []n8n.Workflow{
+		{Nodes: []n8n.Node{{Name: "Slack", Credentials: map[string]interface{}{"slackApi": map[string]interface{}{"id": "2"}}}}},
+		{Nodes: []n8n.Node{{Name: "HTTP", Credentials: map[string]interface{}{"httpBasicAuth": map[string]interface{}{"id": "5"}}}}},
+	}
+
+	referenced := make(map[string]bool)
+	for _, workflow := range workflows {
+		addCredentialReferences(referenced, workflow)
+	}
+
+	assert.Equal(t, []string{"3", "9"}, orphanedCredentialIDs([]string{"9", "2", "3", "5"}, referenced))
+	assert.Empty(t, orphanedCredentialIDs([]string{"2"}, referenced))
+}
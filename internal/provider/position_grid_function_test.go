@@ -0,0 +1,50 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionNodesOnGrid(t *testing.T) {
+	positioned, err := positionNodesOnGrid(`[
+		{"name": "Webhook", "position": [0, 0]},
+		{"name": "Set"},
+		{"name": "Respond", "typeVersion": 1.1}
+	]`, 2)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"Webhook","position":[0,0]},{"name":"Set","position":[250,0]},{"name":"Respond","position":[0,200],"typeVersion":1.1}]`, positioned)
+
+	_, err = positionNodesOnGrid(`{"name": "Set"}`, 2)
+	assert.Error(t, err, "nodes must be an array")
+
+	_, err = positionNodesOnGrid(`["Set"]`, 2)
+	assert.Error(t, err, "nodes must be objects")
+}
+
+func TestPositionGridFunction(t *testing.T) {
+	run := func(columns int64) function.RunResponse {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`[{"name": "A"}, {"name": "B"}]`), types.Int64Value(columns)}),
+		}
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		(&positionGridFunction{}).Run(context.Background(), req, &resp)
+		return resp
+	}
+
+	resp := run(1)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue(`[{"name":"A","position":[0,0]},{"name":"B","position":[0,200]}]`), resp.Result.Value())
+
+	resp = run(0)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Error(), "at least 1")
+}
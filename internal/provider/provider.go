@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"os"
+	"strconv"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -34,8 +36,35 @@ func New(version string) func() provider.Provider {
 
 // n8nProviderModel maps provider schema data to a Go type.
 type n8nProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host                      types.String `tfsdk:"host"`
+	Token                     types.String `tfsdk:"token"`
+	WorkflowNamePrefix        types.String `tfsdk:"workflow_name_prefix"`
+	WorkflowNameSuffix        types.String `tfsdk:"workflow_name_suffix"`
+	ManagedTag                types.String `tfsdk:"managed_tag"`
+	DebugDumpDir              types.String `tfsdk:"debug_dump_dir"`
+	OTLPEndpoint              types.String `tfsdk:"otlp_endpoint"`
+	ProtectedTags             types.List   `tfsdk:"protected_tags"`
+	IncludePinnedData         types.Bool   `tfsdk:"include_pinned_data"`
+	LintWorkflows             types.Bool   `tfsdk:"lint_workflows"`
+	DisallowedNodeTypes       types.List   `tfsdk:"disallowed_node_types"`
+	AllowedCredentialTypes    types.List   `tfsdk:"allowed_credential_types"`
+	DisallowedCredentialTypes types.List   `tfsdk:"disallowed_credential_types"`
+	WorkflowLimits            types.Object `tfsdk:"workflow_limits"`
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	AuditLog                  types.String `tfsdk:"audit_log"`
+}
+
+// resourceProviderData is made available to resources on configure. It holds
+// the API client and the provider settings applied to managed resources.
+type resourceProviderData struct {
+	client              *n8n.Client
+	workflowNames       workflowNamePolicy
+	managedTag          string
+	protectedTags       []string
+	lintWorkflows       bool
+	disallowedNodeTypes []string
+	credentialTypes     credentialTypePolicy
+	limits              workflowLimits
 }
 
 // n8nProvider is the provider implementation.
@@ -66,6 +95,84 @@ func (p *n8nProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"workflow_limits": schema.SingleNestedAttribute{
+				Description: "Size and complexity limits of n8n_workflow resources, checked when their nodes or connections are created or changed. Giant workflows slow down the n8n editor and executions. Exceeded limits are reported as warnings unless `enforce` is set. Read-only workflows are not checked.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_nodes": schema.Int64Attribute{
+						Description: "Maximum number of nodes of a workflow, sticky notes excluded. Not checked if unset or 0.",
+						Optional:    true,
+					},
+					"max_size": schema.Int64Attribute{
+						Description: "Maximum size in bytes of the nodes and connections of a workflow, as compacted JSON. Not checked if unset or 0.",
+						Optional:    true,
+					},
+					"max_expression_depth": schema.Int64Attribute{
+						Description: "Maximum nesting of parentheses, brackets and braces in the expressions of node parameters, `{{ }}` blocks counting as the first level. Not checked if unset or 0.",
+						Optional:    true,
+					},
+					"enforce": schema.BoolAttribute{
+						Description: "Whether plans exceeding a limit fail rather than warn. Defaults to `false`.",
+						Optional:    true,
+					},
+				},
+			},
+			"workflow_name_prefix": schema.StringAttribute{
+				Description: "Prefix added to the name of every n8n_workflow managed by this provider, e.g. `dev-` for environments sharing an instance. Workflow names in configuration and state do not include it.",
+				Optional:    true,
+			},
+			"workflow_name_suffix": schema.StringAttribute{
+				Description: "Suffix added to the name of every n8n_workflow managed by this provider. Workflow names in configuration and state do not include it.",
+				Optional:    true,
+			},
+			"managed_tag": schema.StringAttribute{
+				Description: "Name of a tag, such as `terraform-managed`, added to every n8n_workflow created by this provider so that UI users can tell which workflows are managed by Terraform. The tag is created when it does not exist.",
+				Optional:    true,
+			},
+			"allowed_credential_types": schema.ListAttribute{
+				Description: "Types of credentials, such as `slackOAuth2Api`, that the nodes of n8n_workflow resources may use. Plans of workflows with a node using a credential of another type fail. Read-only workflows are not checked. Defaults to allowing every type.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"debug_dump_dir": schema.StringAttribute{
+				Description: "Directory where the JSON payload of every request sent to and response received from the n8n API is written, one file each, to reproduce API errors outside Terraform. Values of fields that look like secrets, such as passwords or tokens in node parameters, are redacted. Meant for troubleshooting only. May also be provided via `N8N_DEBUG_DUMP_DIR` environment variable.",
+				Optional:    true,
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				Description: "URL of an OTLP/HTTP endpoint, such as `http://localhost:4318`, receiving an OpenTelemetry span for every call made to the n8n API. Spans are children of the trace context in the `TRACEPARENT` environment variable when it is set, so that they show up in the trace of the CI job running Terraform. May also be provided via `N8N_OTLP_ENDPOINT` environment variable.",
+				Optional:    true,
+			},
+			"disallowed_node_types": schema.ListAttribute{
+				Description: "Types of nodes, such as `n8n-nodes-base.executeCommand` or `n8n-nodes-base.code`, that n8n_workflow resources may not contain, to enforce a security policy centrally. Plans of workflows containing such a node fail. Read-only workflows are not checked.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"disallowed_credential_types": schema.ListAttribute{
+				Description: "Types of credentials, such as `gmailOAuth2` for personal Gmail accounts, that the nodes of n8n_workflow resources may not use. Plans of workflows with a node using such a credential fail, even if the type is listed in `allowed_credential_types`. Read-only workflows are not checked.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"include_pinned_data": schema.BoolAttribute{
+				Description: "Whether workflows read from n8n include their pinned data. Defaults to `false`, as pinned test data can make workflows many times larger and is not managed by the provider. Workflows setting `pinData` in the `extra_fields` of an n8n_workflow are always read with their pinned data.",
+				Optional:    true,
+			},
+			"lint_workflows": schema.BoolAttribute{
+				Description: "Whether plans creating or changing an n8n_workflow warn about common problems in its content: nodes connected to no other node, IF nodes with a branch leading nowhere, HTTP Request nodes without a timeout, and active schedules without an error workflow. Defaults to `false`.",
+				Optional:    true,
+			},
+			"audit_log": schema.StringAttribute{
+				Description: "Path of a file where a JSON line is appended for every API call changing an n8n instance, with its time, the user running Terraform, the method and path of the call, its status, and the ID and version of the changed workflow before and after the call, to correlate the changes applied by Terraform with the audit trail of n8n. The file is created readable only by the current user. May also be provided via `N8N_AUDIT_LOG` environment variable.",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Whether the provider refuses to create, update or delete anything, for pipelines previewing changes with a token that must never change the instance. Plans, data sources and refreshes work as usual, while applies fail before sending any request changing the instance. The `validate_on_plan` check of n8n_workflow is skipped, as it creates a copy of the workflow. Defaults to `false`. May also be provided via `N8N_READ_ONLY` environment variable.",
+				Optional:    true,
+			},
+			"protected_tags": schema.ListAttribute{
+				Description: "Names of tags, such as `protected`, that prevent n8n_workflow resources from deleting or replacing the workflows carrying them. Plans destroying such a workflow fail, and so does the delete if the tag was added after the plan. The tags are read from n8n, so they can be set in the editor. Read-only workflows are never deleted and are not checked.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -101,6 +208,133 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	if config.WorkflowNamePrefix.IsUnknown() || config.WorkflowNameSuffix.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown Workflow Name Policy",
+			"The provider cannot apply the workflow name prefix or suffix as their configuration value is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ManagedTag.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("managed_tag"),
+			"Unknown Managed Tag",
+			"The provider cannot tag managed workflows as the configuration value for the managed tag is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.DebugDumpDir.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("debug_dump_dir"),
+			"Unknown Debug Dump Directory",
+			"The provider cannot dump API payloads as the configuration value for the debug dump directory is unknown. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_DEBUG_DUMP_DIR environment variable.",
+		)
+	}
+
+	if config.OTLPEndpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("otlp_endpoint"),
+			"Unknown OTLP Endpoint",
+			"The provider cannot trace API calls as the configuration value for the OTLP endpoint is unknown. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_OTLP_ENDPOINT environment variable.",
+		)
+	}
+
+	if config.ProtectedTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected_tags"),
+			"Unknown Protected Tags",
+			"The provider cannot protect workflows from deletion as the configuration value for the protected tags is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.IncludePinnedData.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("include_pinned_data"),
+			"Unknown Include Pinned Data",
+			"The provider cannot tell whether to read pinned data as the configuration value for include_pinned_data is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.LintWorkflows.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lint_workflows"),
+			"Unknown Lint Workflows",
+			"The provider cannot tell whether to lint workflows as the configuration value for lint_workflows is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.DisallowedNodeTypes.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disallowed_node_types"),
+			"Unknown Disallowed Node Types",
+			"The provider cannot enforce the node type policy as the configuration value for the disallowed node types is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.AllowedCredentialTypes.IsUnknown() || config.DisallowedCredentialTypes.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown Credential Type Policy",
+			"The provider cannot enforce the credential type policy as the configuration value for the allowed or disallowed credential types is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.WorkflowLimits.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workflow_limits"),
+			"Unknown Workflow Limits",
+			"The provider cannot check the size of workflows as the configuration value for the workflow limits is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown Read Only",
+			"The provider cannot tell whether to refuse changes as the configuration value for read_only is unknown. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_READ_ONLY environment variable.",
+		)
+	}
+
+	if config.AuditLog.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("audit_log"),
+			"Unknown Audit Log",
+			"The provider cannot audit API calls as the configuration value for the audit log is unknown. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_AUDIT_LOG environment variable.",
+		)
+	}
+
+	var protectedTags []string
+	if !config.ProtectedTags.IsUnknown() {
+		resp.Diagnostics.Append(config.ProtectedTags.ElementsAs(ctx, &protectedTags, false)...)
+	}
+
+	var disallowedNodeTypes []string
+	if !config.DisallowedNodeTypes.IsUnknown() {
+		resp.Diagnostics.Append(config.DisallowedNodeTypes.ElementsAs(ctx, &disallowedNodeTypes, false)...)
+	}
+
+	var credentialTypes credentialTypePolicy
+	if !config.AllowedCredentialTypes.IsUnknown() && !config.DisallowedCredentialTypes.IsUnknown() {
+		resp.Diagnostics.Append(config.AllowedCredentialTypes.ElementsAs(ctx, &credentialTypes.allowed, false)...)
+		resp.Diagnostics.Append(config.DisallowedCredentialTypes.ElementsAs(ctx, &credentialTypes.disallowed, false)...)
+	}
+
+	var limits *workflowLimitsModel
+	if !config.WorkflowLimits.IsUnknown() {
+		resp.Diagnostics.Append(config.WorkflowLimits.As(ctx, &limits, basetypes.ObjectAsOptions{})...)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -109,6 +343,10 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	// with Terraform configuration value if set.
 	host := os.Getenv("N8N_HOST")
 	token := os.Getenv("N8N_TOKEN")
+	dumpDir := os.Getenv("N8N_DEBUG_DUMP_DIR")
+	otlpEndpoint := os.Getenv("N8N_OTLP_ENDPOINT")
+	readOnly, _ := strconv.ParseBool(os.Getenv("N8N_READ_ONLY"))
+	auditLog := os.Getenv("N8N_AUDIT_LOG")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -118,6 +356,22 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		token = config.Token.ValueString()
 	}
 
+	if !config.DebugDumpDir.IsNull() {
+		dumpDir = config.DebugDumpDir.ValueString()
+	}
+
+	if !config.OTLPEndpoint.IsNull() {
+		otlpEndpoint = config.OTLPEndpoint.ValueString()
+	}
+
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
+	if !config.AuditLog.IsNull() {
+		auditLog = config.AuditLog.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 	if host == "" {
@@ -162,10 +416,62 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	if dumpDir != "" {
+		tflog.Warn(ctx, "Dumping n8n API payloads", map[string]any{"dir": dumpDir})
+		client.DumpDir = dumpDir
+		endpointClients.setDumpDir(dumpDir)
+	}
+
+	// Pinned data is left out of workflows unless requested
+	client.ExcludePinnedData = !config.IncludePinnedData.ValueBool()
+	endpointClients.setExcludePinnedData(client.ExcludePinnedData)
+
+	// Nothing is changed on the instance in read-only mode
+	if readOnly {
+		tflog.Info(ctx, "Refusing changes in read-only mode")
+	}
+	client.ReadOnly = readOnly
+	endpointClients.setReadOnly(readOnly)
+
+	if auditLog != "" {
+		audit, err := n8n.OpenAuditLog(auditLog, auditActor())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("audit_log"), "Unable to Open Audit Log", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Auditing n8n API calls", map[string]any{"path": auditLog})
+		client.Audit = audit
+		endpointClients.setAudit(audit)
+	}
+
+	if otlpEndpoint != "" {
+		tracer, err := newTracer(ctx, otlpEndpoint)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("otlp_endpoint"), "Invalid OTLP Endpoint", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Tracing n8n API calls", map[string]any{"endpoint": otlpEndpoint})
+		client.Tracer = tracer
+		client.TraceParent = traceParentFromEnv()
+		endpointClients.setTracing(client.Tracer, client.TraceParent)
+	}
+
 	// Make the n8n client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &resourceProviderData{
+		client: client,
+		workflowNames: workflowNamePolicy{
+			prefix: config.WorkflowNamePrefix.ValueString(),
+			suffix: config.WorkflowNameSuffix.ValueString(),
+		},
+		managedTag:          config.ManagedTag.ValueString(),
+		protectedTags:       protectedTags,
+		lintWorkflows:       config.LintWorkflows.ValueBool(),
+		disallowedNodeTypes: disallowedNodeTypes,
+		credentialTypes:     credentialTypes,
+		limits:              workflowLimitsFromModel(limits),
+	}
 
 	tflog.Info(ctx, "Configured n8n client", map[string]any{"success": true})
 }
@@ -175,6 +481,15 @@ func (p *n8nProvider) DataSources(_ context.Context) []func() datasource.DataSou
 	return []func() datasource.DataSource{
 		NewWorkflowsDataSource,
 		NewWorkflowDataSource,
+		NewWorkflowComparisonDataSource,
+		NewWorkflowTemplateDataSource,
+		NewWorkflowCredentialsDataSource,
+		NewOrphanedCredentialsDataSource,
+		NewWorkflowsByCredentialDataSource,
+		NewWorkflowDependenciesDataSource,
+		NewAPIKeyScopesDataSource,
+		NewInstanceChecksDataSource,
+		NewExecutionDataDataSource,
 	}
 }
 
@@ -182,10 +497,22 @@ func (p *n8nProvider) DataSources(_ context.Context) []func() datasource.DataSou
 func (p *n8nProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWorkflowResource,
+		NewWorkflowCloneResource,
+		NewWorkflowSettingsPolicyResource,
+		NewWorkflowActivationResource,
+		NewWorkflowSettingsResource,
+		NewTagResource,
+		NewVariablesResource,
 	}
 }
 
 // Functions defines the functions implemented in the provider.
 func (p *n8nProvider) Functions(_ context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewExtractCredentialsFunction,
+		NewStripCredentialsFunction,
+		NewWebhookEndpointsFunction,
+		NewPositionGridFunction,
+		NewInterpolateConnectionsFunction,
+	}
 }
@@ -0,0 +1,27 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// refuseInReadOnlyMode adds an error to diagnostics and returns true when the
+// provider is configured with read_only, so that resources fail before
+// sending any request instead of on the first one changing the instance.
+// action is the refused operation, such as "created".
+func refuseInReadOnlyMode(client *n8n.Client, action string, diagnostics *diag.Diagnostics) bool {
+	if client == nil || !client.ReadOnly {
+		return false
+	}
+
+	diagnostics.AddError(
+		"Provider is read-only",
+		fmt.Sprintf("The n8n provider is configured with read_only, so the resource cannot be %s. Read-only mode is meant for pipelines that only plan and read data sources, with a token that must never change the instance. Apply with a provider configuration leaving read_only unset, or N8N_READ_ONLY unset, to make the change.", action),
+	)
+	return true
+}
@@ -0,0 +1,30 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefuseInReadOnlyMode(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	client := server.Client()
+
+	var resp resource.DeleteResponse
+	assert.False(t, refuseInReadOnlyMode(client, "deleted", &resp.Diagnostics))
+	assert.False(t, refuseInReadOnlyMode(nil, "deleted", &resp.Diagnostics), "unconfigured provider")
+	assert.False(t, resp.Diagnostics.HasError())
+
+	// Refused before reading the state, with no request sent
+	client.ReadOnly = true
+	r := &tagResource{client: client}
+	r.Delete(context.Background(), resource.DeleteRequest{}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "cannot be deleted")
+}
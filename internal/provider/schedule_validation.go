@@ -0,0 +1,226 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	scheduleTriggerNodeType = "n8n-nodes-base.scheduleTrigger"
+	cronNodeType            = "n8n-nodes-base.cron"
+)
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string // names of the values starting at min, e.g. months
+}
+
+var (
+	cronSecondField = cronField{name: "second", min: 0, max: 59}
+	cronFields      = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+		{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+	}
+)
+
+// cronPresets are the named schedules accepted in place of a cron expression.
+var cronPresets = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true, "@daily": true,
+	"@hourly": true, "@minutely": true, "@secondly": true, "@weekdays": true, "@weekends": true,
+}
+
+// scheduleIntervalLimits are the bounds of the interval parameters of the
+// Schedule Trigger node.
+var scheduleIntervalLimits = []struct {
+	parameter string
+	min, max  int
+}{
+	{"secondsInterval", 1, 59},
+	{"minutesInterval", 1, 59},
+	{"hoursInterval", 1, 23},
+	{"daysInterval", 1, 31},
+	{"weeksInterval", 1, 52},
+	{"monthsInterval", 1, 12},
+	{"triggerAtHour", 0, 23},
+	{"triggerAtMinute", 0, 59},
+}
+
+// warnAboutInvalidSchedules warns about invalid schedules in nodes that are
+// created or changed by the plan. n8n accepts them and activates the
+// workflow, but the trigger never fires.
+func warnAboutInvalidSchedules(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planNodes, stateNodes types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("nodes"), &planNodes)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("nodes"), &stateNodes)...)
+	}
+	if resp.Diagnostics.HasError() || planNodes.IsUnknown() || planNodes.IsNull() || planNodes.Equal(stateNodes) {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(planNodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	for _, problem := range scheduleProblems(nodes) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("nodes"),
+			"Invalid schedule",
+			fmt.Sprintf("Workflow %s. n8n activates the workflow anyway, but the trigger will never fire.", problem),
+		)
+	}
+}
+
+// scheduleProblems returns a description of each invalid cron expression or
+// interval of the Schedule Trigger and legacy Cron nodes. Values set by an
+// expression are skipped since they are only known at run time.
+func scheduleProblems(nodes []n8n.Node) []string {
+	var problems []string
+	for _, node := range nodes {
+		// The Schedule Trigger stores cron expressions in the expression
+		// parameter of cronExpression rules, the Cron node in the
+		// cronExpression parameter of custom trigger times
+		var rules []interface{}
+		var kindKey, cronKind, expressionKey string
+		switch node.Type {
+		case scheduleTriggerNodeType:
+			rules = nestedList(node.Parameters, "rule", "interval")
+			kindKey, cronKind, expressionKey = "field", "cronExpression", "expression"
+		case cronNodeType:
+			rules = nestedList(node.Parameters, "triggerTimes", "item")
+			kindKey, cronKind, expressionKey = "mode", "custom", "cronExpression"
+		default:
+			continue
+		}
+
+		for _, rule := range rules {
+			values, ok := rule.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			expression, ok := values[expressionKey].(string)
+			if values[kindKey] == cronKind && ok && !strings.HasPrefix(expression, "=") {
+				if err := validateCronExpression(expression); err != nil {
+					problems = append(problems, fmt.Sprintf("node %q: invalid cron expression %q: %s", node.Name, expression, err))
+				}
+			}
+			for _, limit := range scheduleIntervalLimits {
+				value, ok := values[limit.parameter].(float64)
+				if ok && (value < float64(limit.min) || value > float64(limit.max)) {
+					problems = append(problems, fmt.Sprintf("node %q: %s is %v, expected a value between %d and %d", node.Name, limit.parameter, value, limit.min, limit.max))
+				}
+			}
+		}
+	}
+	return problems
+}
+
+// nestedList returns the list found under the given keys of node parameters,
+// or nil if there is none.
+func nestedList(parameters map[string]interface{}, keys ...string) []interface{} {
+	var value interface{} = parameters
+	for _, key := range keys {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	list, _ := value.([]interface{})
+	return list
+}
+
+// validateCronExpression checks the syntax and value ranges of a cron
+// expression with five fields, or six when seconds are included.
+func validateCronExpression(expression string) error {
+	parts := strings.Fields(expression)
+	if len(parts) == 1 && cronPresets[strings.ToLower(parts[0])] {
+		return nil
+	}
+
+	fields := cronFields
+	switch len(parts) {
+	case 5:
+	case 6:
+		fields = append([]cronField{cronSecondField}, cronFields...)
+	default:
+		return fmt.Errorf("expected 5 or 6 fields, got %d", len(parts))
+	}
+
+	for i, part := range parts {
+		if err := validateCronField(part, fields[i]); err != nil {
+			return fmt.Errorf("%s field %q: %w", fields[i].name, part, err)
+		}
+	}
+	return nil
+}
+
+// validateCronField checks a comma-separated list of values, ranges and steps.
+func validateCronField(part string, field cronField) error {
+	for _, item := range strings.Split(part, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid step %q", step)
+			}
+		}
+
+		if base == "*" || (base == "?" && (field.name == "day of month" || field.name == "day of week")) {
+			continue
+		}
+
+		low, high, isRange := strings.Cut(base, "-")
+		lowValue, err := cronValue(low, field)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		highValue, err := cronValue(high, field)
+		if err != nil {
+			return err
+		}
+		if lowValue > highValue {
+			return fmt.Errorf("range %s is reversed", base)
+		}
+	}
+	return nil
+}
+
+// cronValue parses a single value of a cron field, given as a number or name.
+func cronValue(value string, field cronField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return field.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, field.min, field.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCronExpression(t *testing.T) {
+	valid := []string{
+		"0 9 * * 1-5",
+		"*/15 * * * *",
+		"30 0 9 1,15 * ?",
+		"0 0 1 jan-mar MON",
+		"@daily",
+		"0 0 * * 7",
+	}
+	for _, expression := range valid {
+		assert.NoError(t, validateCronExpression(expression), expression)
+	}
+
+	invalid := map[string]string{
+		"0 9 * *":       "expected 5 or 6 fields",
+		"61 * * * *":    "minute field",
+		"0 24 * * *":    "out of range 0-23",
+		"0 0 0 * *":     "day of month field",
+		"0 0 * 13 *":    "month field",
+		"*/0 * * * *":   "invalid step",
+		"0 0 * * 5-1":   "reversed",
+		"0 0 L * *":     "invalid value",
+		"0 0 * * * * *": "got 7",
+	}
+	for expression, message := range invalid {
+		assert.ErrorContains(t, validateCronExpression(expression), message, expression)
+	}
+}
+
+func TestScheduleProblems(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Every weekday", Type: scheduleTriggerNodeType, Parameters: map[string]interface{}{
+			"rule": map[string]interface{}{"interval": []interface{}{
+				map[string]interface{}{"field": "cronExpression", "expression": "0 9 * * 1-5"},
+				map[string]interface{}{"field": "cronExpression", "expression": "0 25 * * *"},
+				map[string]interface{}{"field": "cronExpression", "expression": "={{ $json.cron }}"},
+				map[string]interface{}{"field": "days", "expression": "not a cron"},
+				map[string]interface{}{"field": "minutes", "minutesInterval": float64(0)},
+				map[string]interface{}{"field": "hours", "hoursInterval": float64(6), "triggerAtMinute": float64(15)},
+			}},
+		}},
+		{Name: "Legacy", Type: cronNodeType, Parameters: map[string]interface{}{
+			"triggerTimes": map[string]interface{}{"item": []interface{}{
+				map[string]interface{}{"mode": "custom", "cronExpression": "* * *"},
+				map[string]interface{}{"mode": "everyDay", "cronExpression": "ignored"},
+			}},
+		}},
+		{Name: "Set", Type: "n8n-nodes-base.set", Parameters: map[string]interface{}{"cronExpression": "nope"}},
+	}
+
+	problems := scheduleProblems(nodes)
+	assert.Len(t, problems, 3)
+	assert.Contains(t, problems[0], `node "Every weekday": invalid cron expression "0 25 * * *"`)
+	assert.Contains(t, problems[1], "minutesInterval is 0, expected a value between 1 and 59")
+	assert.Contains(t, problems[2], `node "Legacy"`)
+}
@@ -127,3 +127,72 @@ func workflowsTagsAttr() schema.ListNestedAttribute {
 		},
 	}
 }
+
+func workflowsNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the workflow.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the workflow.",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether the workflow is currently active.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the current version of the workflow.",
+			},
+			"trigger_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of triggers n8n registered for the workflow. Zero while the workflow is inactive.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the workflow was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the workflow was last updated.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: contentHashDescription,
+			},
+			"nodes": workflowsNodeAttr(),
+			"connections": schema.StringAttribute{
+				Computed:    true,
+				Description: "Raw JSON representation of connections between nodes.",
+			},
+			"settings": workflowsSettingsAttr(),
+			"tags":     workflowsTagsAttr(),
+		},
+	}
+}
+
+func credentialReferenceNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Credential type, such as `slackApi`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the credential, empty when the node does not reference one.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the credential as stored in the node.",
+			},
+			"node": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the node using the credential.",
+			},
+		},
+	}
+}
@@ -65,3 +65,19 @@ func TestWorkflowsTagsAttr(t *testing.T) {
 		assert.Contains(t, attributes, key)
 	}
 }
+
+func TestWorkflowsNestedObject(t *testing.T) {
+	attributes := workflowsNestedObject().Attributes
+
+	for _, name := range []string{"id", "name", "active", "version_id", "trigger_count", "created_at", "updated_at", "content_hash", "nodes", "connections", "settings", "tags"} {
+		assert.Contains(t, attributes, name)
+	}
+}
+
+func TestCredentialReferenceNestedObject(t *testing.T) {
+	attributes := credentialReferenceNestedObject().Attributes
+
+	for _, name := range []string{"type", "id", "name", "node"} {
+		assert.Contains(t, attributes, name)
+	}
+}
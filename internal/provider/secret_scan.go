@@ -0,0 +1,153 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// secretPatterns match well-known formats of secrets found in parameter
+// values.
+var secretPatterns = []struct {
+	kind    string
+	pattern *regexp.Regexp
+}{
+	{"an AWS access key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"a bearer token", regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]{20,}=*`)},
+	{"a private key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{"a JSON Web Token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]+`)},
+	{"a GitHub token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+	{"a Slack token", regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z-]{10,}`)},
+	{"an API secret key", regexp.MustCompile(`\b(sk|rk)[-_](live[-_]|proj[-_])?[A-Za-z0-9]{20,}`)},
+}
+
+// secretNameFragments are the lowercase fragments of parameter names, or of
+// the names of header and query parameters, whose values are secrets.
+var secretNameFragments = []string{"password", "secret", "token", "apikey", "api_key", "api-key", "authorization", "privatekey", "private_key", "accesskey", "access_key"}
+
+const (
+	// minSecretLength is the length from which values of parameters named
+	// like secrets are checked for randomness.
+	minSecretLength = 16
+
+	// minSecretEntropy is the Shannon entropy, in bits per character, from
+	// which values of parameters named like secrets are reported. Random
+	// keys are well above it, words and placeholders below.
+	minSecretEntropy = 3.5
+)
+
+// warnAboutInlineSecrets warns about secrets written inline in the parameters
+// of nodes created or changed by the plan. Node parameters end up in the
+// Terraform state and usually in git, unlike n8n credentials and variables.
+func warnAboutInlineSecrets(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planNodes, stateNodes types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("nodes"), &planNodes)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("nodes"), &stateNodes)...)
+	}
+	if resp.Diagnostics.HasError() || planNodes.IsUnknown() || planNodes.IsNull() || planNodes.Equal(stateNodes) {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(planNodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	for _, secret := range inlineSecrets(nodes) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("nodes"),
+			"Possible secret in node parameters",
+			fmt.Sprintf("%s. Node parameters are stored in the Terraform state and in the configuration, so secrets written there leak to everyone reading them. Store the secret in an n8n credential, or reference an n8n variable with an expression such as {{ $vars.API_KEY }}.", secret),
+		)
+	}
+}
+
+// inlineSecrets returns a description of each parameter value of the given
+// nodes that looks like a secret, without the value itself. Expressions are
+// skipped since they reference values rather than holding them.
+func inlineSecrets(nodes []n8n.Node) []string {
+	var secrets []string
+	for _, node := range nodes {
+		var found []string
+		scanParameters(node.Parameters, "parameters", false, &found)
+		sort.Strings(found)
+		for _, secret := range found {
+			secrets = append(secrets, fmt.Sprintf("Node %q: %s", node.Name, secret))
+		}
+	}
+	return secrets
+}
+
+// scanParameters appends a description of each secret found in a decoded
+// parameter value at the given path. secretName is set for values of
+// parameters named like secrets.
+func scanParameters(value interface{}, at string, secretName bool, found *[]string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		// Header and query parameters are name and value pairs
+		name, _ := value["name"].(string)
+		for key, child := range value {
+			childSecret := isSecretName(key) || (key == "value" && isSecretName(name))
+			scanParameters(child, at+"."+key, childSecret, found)
+		}
+	case []interface{}:
+		for i, child := range value {
+			scanParameters(child, fmt.Sprintf("%s[%d]", at, i), secretName, found)
+		}
+	case string:
+		if strings.HasPrefix(value, "=") || strings.Contains(value, "{{") {
+			return
+		}
+		for _, secret := range secretPatterns {
+			if secret.pattern.MatchString(value) {
+				*found = append(*found, fmt.Sprintf("%s looks like %s", at, secret.kind))
+				return
+			}
+		}
+		if secretName && len(value) >= minSecretLength && shannonEntropy(value) >= minSecretEntropy {
+			*found = append(*found, fmt.Sprintf("%s is named like a secret and holds a random-looking value", at))
+		}
+	}
+}
+
+// isSecretName reports whether a parameter name names a secret.
+func isSecretName(name string) bool {
+	name = strings.ToLower(name)
+	for _, fragment := range secretNameFragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// shannonEntropy returns the Shannon entropy of a string, in bits per
+// character.
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
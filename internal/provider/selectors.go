@@ -0,0 +1,137 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// workflowHasAllTags reports whether a workflow has every tag in tagNames.
+// An empty tagNames matches every workflow.
+func workflowHasAllTags(workflow n8n.Workflow, tagNames []string) bool {
+	for _, name := range tagNames {
+		found := false
+		for _, tag := range workflow.Tags {
+			if tag.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// workflowsWithAllTags returns the workflows of the instance that have every
+// tag in tagNames. The tags filter the list on the server, and are checked
+// again on each workflow returned.
+func workflowsWithAllTags(client *n8n.Client, tagNames []string) ([]n8n.Workflow, error) {
+	return projectWorkflowsWithAllTags(client, "", tagNames)
+}
+
+// projectWorkflowsWithAllTags returns the workflows of the project with the
+// given ID that have every tag in tagNames, or those of the whole instance
+// when projectID is empty.
+func projectWorkflowsWithAllTags(client *n8n.Client, projectID string, tagNames []string) ([]n8n.Workflow, error) {
+	var response *n8n.WorkflowsResponse
+	var err error
+	if projectID == "" {
+		response, err = client.GetWorkflows(tagListOptions(tagNames)...)
+	} else {
+		response, err = client.GetProjectWorkflows(projectID, tagListOptions(tagNames)...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var workflows []n8n.Workflow
+	for _, workflow := range response.Data {
+		if workflowHasAllTags(workflow, tagNames) {
+			workflows = append(workflows, workflow)
+		}
+	}
+	return workflows, nil
+}
+
+// tagListOptions returns the options listing the workflows with any of the
+// given tags. The n8n API may match any tag rather than all of them, so the
+// listed workflows are still to be filtered with workflowHasAllTags.
+func tagListOptions(tagNames []string) []n8n.ListOption {
+	opts := make([]n8n.ListOption, 0, len(tagNames))
+	for _, name := range tagNames {
+		opts = append(opts, n8n.WithTag(name))
+	}
+	return opts
+}
+
+// workflowsNamed returns the workflows of the instance with the given name.
+func workflowsNamed(client *n8n.Client, name string) ([]n8n.Workflow, error) {
+	response, err := client.GetWorkflows()
+	if err != nil {
+		return nil, err
+	}
+
+	var workflows []n8n.Workflow
+	for _, workflow := range response.Data {
+		if workflow.Name == name {
+			workflows = append(workflows, workflow)
+		}
+	}
+	return workflows, nil
+}
+
+// workflowIDs returns the IDs of the given workflows.
+func workflowIDs(workflows []n8n.Workflow) []string {
+	ids := make([]string, 0, len(workflows))
+	for _, workflow := range workflows {
+		ids = append(ids, workflow.ID)
+	}
+	return ids
+}
+
+// duplicateWorkflowNames returns the IDs of the workflows sharing their name
+// with another workflow, keyed by name.
+func duplicateWorkflowNames(workflows []n8n.Workflow) map[string][]string {
+	idsByName := make(map[string][]string)
+	for _, workflow := range workflows {
+		idsByName[workflow.Name] = append(idsByName[workflow.Name], workflow.ID)
+	}
+
+	duplicates := make(map[string][]string)
+	for name, ids := range idsByName {
+		if len(ids) > 1 {
+			duplicates[name] = ids
+		}
+	}
+	return duplicates
+}
+
+// tagSelectorID returns an identifier for a tag selector that does not depend
+// on the order of the tags.
+func tagSelectorID(tagNames []string) string {
+	if len(tagNames) == 0 {
+		return "all"
+	}
+	sorted := append([]string(nil), tagNames...)
+	sort.Strings(sorted)
+	return "tags:" + strings.Join(sorted, ",")
+}
+
+// workflowSelectorID returns an identifier for a selector of workflows by
+// project and tags that does not depend on the order of the tags. Selectors
+// without a project have the identifier of their tags alone.
+func workflowSelectorID(projectID string, tagNames []string) string {
+	if projectID == "" {
+		return tagSelectorID(tagNames)
+	}
+	if len(tagNames) == 0 {
+		return "project:" + projectID
+	}
+	return "project:" + projectID + "/" + tagSelectorID(tagNames)
+}
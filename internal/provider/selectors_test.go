@@ -0,0 +1,111 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+func TestWorkflowHasAllTags(t *testing.T) {
+	workflow := n8n.Workflow{Tags: []n8n.Tag{{Name: "nightly-batch"}, {Name: "finance"}}}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected bool
+	}{
+		{name: "no filter", tags: nil, expected: true},
+		{name: "single tag", tags: []string{"finance"}, expected: true},
+		{name: "all tags", tags: []string{"finance", "nightly-batch"}, expected: true},
+		{name: "missing tag", tags: []string{"finance", "marketing"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workflowHasAllTags(workflow, tt.tags); got != tt.expected {
+				t.Errorf("workflowHasAllTags(%v) = %v, want %v", tt.tags, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTagSelectorID(t *testing.T) {
+	if got := tagSelectorID(nil); got != "all" {
+		t.Errorf("tagSelectorID(nil) = %q, want %q", got, "all")
+	}
+	if got := tagSelectorID([]string{"production", "billing"}); got != "tags:billing,production" {
+		t.Errorf("tagSelectorID() = %q, want %q", got, "tags:billing,production")
+	}
+}
+
+func TestWorkflowSelectorID(t *testing.T) {
+	tests := []struct {
+		projectID string
+		tags      []string
+		expected  string
+	}{
+		{"", []string{"production"}, "tags:production"},
+		{"p1", nil, "project:p1"},
+		{"p1", []string{"production", "billing"}, "project:p1/tags:billing,production"},
+	}
+	for _, tt := range tests {
+		if got := workflowSelectorID(tt.projectID, tt.tags); got != tt.expected {
+			t.Errorf("workflowSelectorID(%q, %v) = %q, want %q", tt.projectID, tt.tags, got, tt.expected)
+		}
+	}
+}
+
+func TestWorkflowsNamed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "wf1", "name": "Nightly report"},
+			{"id": "wf2", "name": "Orders"},
+			{"id": "wf3", "name": "Nightly report"}
+		], "nextCursor": null}`))
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workflows, err := workflowsNamed(client, "Nightly report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(workflows) != 2 || workflows[0].ID != "wf1" || workflows[1].ID != "wf3" {
+		t.Errorf("workflowsNamed() = %v, want wf1 and wf3", workflows)
+	}
+
+	workflows, err = workflowsNamed(client, "Missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(workflows) != 0 {
+		t.Errorf("workflowsNamed() = %v, want none", workflows)
+	}
+}
+
+func TestDuplicateWorkflowNames(t *testing.T) {
+	workflows := []n8n.Workflow{
+		{ID: "wf1", Name: "Nightly report"},
+		{ID: "wf2", Name: "Orders"},
+		{ID: "wf3", Name: "Nightly report"},
+		{ID: "wf4", Name: "Nightly report"},
+	}
+
+	duplicates := duplicateWorkflowNames(workflows)
+	if len(duplicates) != 1 {
+		t.Fatalf("duplicateWorkflowNames() = %v, want only Nightly report", duplicates)
+	}
+	if ids := duplicates["Nightly report"]; len(ids) != 3 || ids[0] != "wf1" || ids[2] != "wf4" {
+		t.Errorf("duplicateWorkflowNames()[\"Nightly report\"] = %v, want [wf1 wf3 wf4]", ids)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// inheritedSettingModifier is a plan modifier for workflow settings that keeps
+// the value read from n8n when the setting is missing from the configuration.
+// n8n fills in settings the configuration did not set, such as the timezone of
+// the instance, so replacing them with the schema default on every plan shows
+// a change that n8n undoes on the next refresh. Defaults still apply when the
+// workflow is created.
+type inheritedSettingModifier struct{}
+
+// inheritedSetting returns a plan modifier that keeps the state value of a
+// setting missing from the configuration instead of its default.
+func inheritedSetting() inheritedSettingModifier {
+	return inheritedSettingModifier{}
+}
+
+func (m inheritedSettingModifier) Description(_ context.Context) string {
+	return "Keeps the value read from n8n when the setting is not configured."
+}
+
+func (m inheritedSettingModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m inheritedSettingModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if inheritsSetting(req.ConfigValue, req.StateValue) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m inheritedSettingModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if inheritsSetting(req.ConfigValue, req.StateValue) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m inheritedSettingModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if inheritsSetting(req.ConfigValue, req.StateValue) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m inheritedSettingModifier) PlanModifyObject(_ context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	if inheritsSetting(req.ConfigValue, req.StateValue) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// inheritsSetting reports whether a setting is left out of the configuration
+// of an existing workflow, so that the value n8n holds is not drift.
+func inheritsSetting(config, state attr.Value) bool {
+	return config.IsNull() && !state.IsNull() && !state.IsUnknown()
+}
+
+// Ensure the modifier handles every type of workflow setting.
+var (
+	_ planmodifier.Bool   = inheritedSettingModifier{}
+	_ planmodifier.Int64  = inheritedSettingModifier{}
+	_ planmodifier.String = inheritedSettingModifier{}
+	_ planmodifier.Object = inheritedSettingModifier{}
+)
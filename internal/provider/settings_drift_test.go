@@ -0,0 +1,71 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInheritedSetting(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   types.String
+		state    types.String
+		expected types.String
+	}{
+		{
+			name:     "omitted setting keeps the value read from n8n",
+			config:   types.StringNull(),
+			state:    types.StringValue("Europe/Berlin"),
+			expected: types.StringValue("Europe/Berlin"),
+		},
+		{
+			name:     "configured setting is planned",
+			config:   types.StringValue("America/New_York"),
+			state:    types.StringValue("Europe/Berlin"),
+			expected: types.StringValue("America/New_York"),
+		},
+		{
+			name:     "omitted setting takes its default on create",
+			config:   types.StringNull(),
+			state:    types.StringNull(),
+			expected: types.StringValue("America/New_York"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.config
+			if plan.IsNull() {
+				plan = types.StringValue("America/New_York")
+			}
+			req := planmodifier.StringRequest{ConfigValue: tt.config, StateValue: tt.state, PlanValue: plan}
+			resp := &planmodifier.StringResponse{PlanValue: plan}
+
+			inheritedSetting().PlanModifyString(context.Background(), req, resp)
+			assert.Equal(t, tt.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestInheritedSettingTypes(t *testing.T) {
+	ctx := context.Background()
+
+	boolResp := &planmodifier.BoolResponse{PlanValue: types.BoolValue(true)}
+	inheritedSetting().PlanModifyBool(ctx, planmodifier.BoolRequest{
+		ConfigValue: types.BoolNull(), StateValue: types.BoolValue(false), PlanValue: types.BoolValue(true),
+	}, boolResp)
+	assert.Equal(t, types.BoolValue(false), boolResp.PlanValue)
+
+	int64Resp := &planmodifier.Int64Response{PlanValue: types.Int64Value(3600)}
+	inheritedSetting().PlanModifyInt64(ctx, planmodifier.Int64Request{
+		ConfigValue: types.Int64Null(), StateValue: types.Int64Unknown(), PlanValue: types.Int64Value(3600),
+	}, int64Resp)
+	assert.Equal(t, types.Int64Value(3600), int64Resp.PlanValue, "unknown state values are not kept")
+}
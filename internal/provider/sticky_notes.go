@@ -0,0 +1,65 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// stickyNoteNodeType is the type of the canvas sticky notes, which only
+// document a workflow and never run.
+const stickyNoteNodeType = "n8n-nodes-base.stickyNote"
+
+// removeStickyNotes returns a decoded array of nodes without its sticky
+// notes. Other values are returned unchanged.
+func removeStickyNotes(v interface{}) interface{} {
+	nodes, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+
+	result := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		if fields, ok := node.(map[string]interface{}); ok && fields["type"] == stickyNoteNodeType {
+			continue
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+// preserveStickyNotes replaces the sticky notes of a JSON-encoded array of
+// nodes with the sticky notes currently stored on the server, so that an
+// update keeps the notes edited in the UI.
+func preserveStickyNotes(nodesJSON string, current []n8n.Node) (string, error) {
+	decoded, err := decodeJSON(nodesJSON)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := decoded.([]interface{}); !ok {
+		return "", errors.New("expected an array of nodes")
+	}
+	nodes := removeStickyNotes(decoded).([]interface{})
+
+	for _, node := range current {
+		if node.Type != stickyNoteNodeType {
+			continue
+		}
+
+		data, err := json.Marshal(node)
+		if err != nil {
+			return "", err
+		}
+		note, err := decodeJSON(string(data))
+		if err != nil {
+			return "", err
+		}
+		nodes = append(nodes, note)
+	}
+
+	return canonicalJSON(nodes)
+}
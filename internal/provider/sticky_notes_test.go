@@ -0,0 +1,47 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreStickyNotesComparison(t *testing.T) {
+	config := `[{"name": "Webhook", "type": "n8n-nodes-base.webhook"}]`
+	server := `[
+		{"name": "Webhook", "type": "n8n-nodes-base.webhook"},
+		{"name": "Sticky Note", "type": "n8n-nodes-base.stickyNote", "parameters": {"content": "Edited in the UI"}}
+	]`
+
+	assert.False(t, jsonSemanticEqualWithOptions(config, server, jsonSemanticOptions{}))
+	assert.True(t, jsonSemanticEqualWithOptions(config, server, jsonSemanticOptions{ignoreStickyNotes: true}))
+
+	differences, err := diffJSON(config, server, jsonSemanticOptions{ignoreStickyNotes: true})
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestPreserveStickyNotes(t *testing.T) {
+	config := `[
+		{"name": "Webhook", "type": "n8n-nodes-base.webhook"},
+		{"name": "Old note", "type": "n8n-nodes-base.stickyNote", "parameters": {"content": "From the configuration"}}
+	]`
+	current := []n8n.Node{
+		{Name: "Webhook", Type: "n8n-nodes-base.webhook"},
+		{Name: "Sticky Note", Type: stickyNoteNodeType, Parameters: map[string]interface{}{"content": "Edited in the UI"}},
+	}
+
+	nodes, err := preserveStickyNotes(config, current)
+	require.NoError(t, err)
+	assert.Contains(t, nodes, `"content":"Edited in the UI"`)
+	assert.NotContains(t, nodes, "From the configuration")
+	assert.Contains(t, nodes, `"name":"Webhook"`)
+
+	_, err = preserveStickyNotes(`{}`, current)
+	assert.Error(t, err)
+}
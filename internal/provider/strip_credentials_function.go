@@ -0,0 +1,77 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &stripCredentialsFunction{}
+
+// NewStripCredentialsFunction returns a new function.
+func NewStripCredentialsFunction() function.Function {
+	return &stripCredentialsFunction{}
+}
+
+type stripCredentialsFunction struct{}
+
+func (f *stripCredentialsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_credentials"
+}
+
+func (f *stripCredentialsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Removes the credentials blocks from workflow nodes",
+		Description: "Returns a JSON-encoded array of workflow nodes without their credentials blocks, in canonical form. Useful to store workflows without credential names and IDs, or to compare workflows across environments using different credentials.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "nodes",
+				Description: "JSON-encoded array of workflow nodes, as in the nodes attribute of n8n_workflow.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *stripCredentialsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var nodesJSON string
+	resp.Error = req.Arguments.Get(ctx, &nodesJSON)
+	if resp.Error != nil {
+		return
+	}
+
+	stripped, err := stripNodeCredentials(nodesJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid nodes JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, stripped)
+}
+
+// stripNodeCredentials removes the credentials block of every node in a
+// JSON-encoded array of nodes. Other node fields are kept as they are.
+func stripNodeCredentials(nodesJSON string) (string, error) {
+	decoded, err := decodeJSON(nodesJSON)
+	if err != nil {
+		return "", err
+	}
+
+	nodes, ok := decoded.([]interface{})
+	if !ok {
+		return "", errors.New("expected an array of nodes")
+	}
+
+	for _, node := range nodes {
+		if fields, ok := node.(map[string]interface{}); ok {
+			delete(fields, "credentials")
+		}
+	}
+
+	return canonicalJSON(nodes)
+}
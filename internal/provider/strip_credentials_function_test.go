@@ -0,0 +1,38 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripNodeCredentials(t *testing.T) {
+	stripped, err := stripNodeCredentials(`[
+		{"name": "Send message", "typeVersion": 2.1, "credentials": {"slackApi": {"id": "12", "name": "Slack bot"}}},
+		{"name": "Wait", "parameters": {"amount": 5}}
+	]`)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"Send message","typeVersion":2.1},{"name":"Wait","parameters":{"amount":5}}]`, stripped)
+
+	_, err = stripNodeCredentials(`{"name": "Wait"}`)
+	assert.Error(t, err, "nodes must be an array")
+}
+
+func TestStripCredentialsFunction(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`[{"name": "Send message", "credentials": {"slackApi": {"id": "12"}}}]`)}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	(&stripCredentialsFunction{}).Run(context.Background(), req, &resp)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue(`[{"name":"Send message"}]`), resp.Result.Value())
+}
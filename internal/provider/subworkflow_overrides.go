@@ -0,0 +1,164 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// subworkflowOverrides returns the workflow IDs of subworkflow_overrides keyed
+// by node name, or nil when the attribute is null or not fully known.
+func subworkflowOverrides(ctx context.Context, attribute types.Map) map[string]string {
+	if attribute.IsNull() || attribute.IsUnknown() {
+		return nil
+	}
+
+	var overrides map[string]types.String
+	if diags := attribute.ElementsAs(ctx, &overrides, false); diags.HasError() {
+		return nil
+	}
+
+	ids := make(map[string]string, len(overrides))
+	for name, id := range overrides {
+		if id.IsUnknown() {
+			return nil
+		}
+		ids[name] = id.ValueString()
+	}
+	return ids
+}
+
+// subworkflowOverrideProblems returns a description of each override naming a
+// node missing from the given nodes or not calling a workflow, ordered by node
+// name.
+func subworkflowOverrideProblems(nodes []n8n.Node, names []string) []string {
+	byName := make(map[string]n8n.Node, len(nodes))
+	for _, node := range nodes {
+		byName[node.Name] = node
+	}
+
+	sort.Strings(names)
+	var problems []string
+	for _, name := range names {
+		node, ok := byName[name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("The workflow has no node named %q.", name))
+		case !subWorkflowNodeTypes[node.Type]:
+			problems = append(problems, fmt.Sprintf("Node %q is of type %s, which does not call a workflow.", name, node.Type))
+		}
+	}
+	return problems
+}
+
+// overrideSubworkflows sets the workflowId parameter of the nodes named in
+// overrides to their workflow ID, keeping the form of the parameter. Newer
+// node versions store it as a resource locator, whose cached workflow name
+// and URL are dropped since they describe the replaced workflow.
+func overrideSubworkflows(nodes []n8n.Node, overrides map[string]string) error {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	if problems := subworkflowOverrideProblems(nodes, names); len(problems) > 0 {
+		return fmt.Errorf("%s", problems[0])
+	}
+
+	for i, node := range nodes {
+		id, ok := overrides[node.Name]
+		if !ok {
+			continue
+		}
+
+		parameters := make(map[string]interface{}, len(node.Parameters)+1)
+		for key, value := range node.Parameters {
+			parameters[key] = value
+		}
+		if _, ok := parameters["workflowId"].(string); ok {
+			parameters["workflowId"] = id
+		} else {
+			parameters["workflowId"] = map[string]interface{}{"__rl": true, "mode": "id", "value": id}
+		}
+		nodes[i].Parameters = parameters
+	}
+	return nil
+}
+
+// restoreSubworkflowIDs sets back the workflowId parameter of the nodes read
+// from n8n that still call the workflow of their override to its value in the
+// prior nodes, so that overrides written at apply time are not reported as
+// drift. Nodes calling another workflow, e.g. after an edit in the n8n
+// editor, are left as read.
+func restoreSubworkflowIDs(nodes []n8n.Node, priorNodesJSON string, overrides map[string]string) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	var prior []n8n.Node
+	if err := json.Unmarshal([]byte(priorNodesJSON), &prior); err != nil {
+		return
+	}
+	priorParameters := make(map[string]map[string]interface{}, len(prior))
+	for _, node := range prior {
+		priorParameters[node.Name] = node.Parameters
+	}
+
+	for i, node := range nodes {
+		id, ok := overrides[node.Name]
+		if !ok || workflowIDParameter(node.Parameters["workflowId"]) != id {
+			continue
+		}
+		configured, ok := priorParameters[node.Name]
+		if !ok {
+			continue
+		}
+
+		parameters := make(map[string]interface{}, len(node.Parameters))
+		for key, value := range node.Parameters {
+			parameters[key] = value
+		}
+		if value, ok := configured["workflowId"]; ok {
+			parameters["workflowId"] = value
+		} else {
+			delete(parameters, "workflowId")
+		}
+		nodes[i].Parameters = parameters
+	}
+}
+
+// checkSubworkflowOverrides fails the plan of a workflow whose
+// subworkflow_overrides name nodes missing from the workflow or not calling
+// a workflow. Overrides with unknown IDs are checked too, as their node names
+// are known.
+func checkSubworkflowOverrides(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	var plan workflowResourceModel
+	if diags := resp.Plan.Get(ctx, &plan); diags.HasError() || plan.SubworkflowOverrides.IsNull() || plan.SubworkflowOverrides.IsUnknown() || plan.Nodes.IsUnknown() || plan.Nodes.IsNull() {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	names := make([]string, 0, len(plan.SubworkflowOverrides.Elements()))
+	for name := range plan.SubworkflowOverrides.Elements() {
+		names = append(names, name)
+	}
+	for _, problem := range subworkflowOverrideProblems(nodes, names) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subworkflow_overrides"),
+			"Invalid sub-workflow override",
+			fmt.Sprintf("%s Only Execute Workflow and Call n8n Workflow Tool nodes can be overridden.", problem),
+		)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverrideSubworkflows(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Call legacy", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "dev1"}},
+		{Name: "Call enrich", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{
+			"workflowId": map[string]interface{}{"__rl": true, "mode": "list", "value": "dev2", "cachedResultName": "Enrich"},
+		}},
+		{Name: "Tool", Type: "@n8n/n8n-nodes-langchain.toolWorkflow", Parameters: map[string]interface{}{"source": "database"}},
+		{Name: "Webhook", Type: webhookNodeType},
+	}
+	configured := nodes[1].Parameters
+
+	require.NoError(t, overrideSubworkflows(nodes, map[string]string{"Call legacy": "prod1", "Call enrich": "prod2", "Tool": "prod3"}))
+	assert.Equal(t, "prod1", nodes[0].Parameters["workflowId"])
+	assert.Equal(t, map[string]interface{}{"__rl": true, "mode": "id", "value": "prod2"}, nodes[1].Parameters["workflowId"])
+	assert.Equal(t, map[string]interface{}{"__rl": true, "mode": "id", "value": "prod3"}, nodes[2].Parameters["workflowId"])
+	assert.Equal(t, "database", nodes[2].Parameters["source"])
+
+	// The parameters of the parsed nodes are not shared
+	assert.Equal(t, "dev2", configured["workflowId"].(map[string]interface{})["value"])
+
+	assert.EqualError(t, overrideSubworkflows(nodes, map[string]string{"Missing": "1"}), `The workflow has no node named "Missing".`)
+	assert.EqualError(t, overrideSubworkflows(nodes, map[string]string{"Webhook": "1"}), `Node "Webhook" is of type n8n-nodes-base.webhook, which does not call a workflow.`)
+	assert.NoError(t, overrideSubworkflows(nodes, nil))
+}
+
+func TestRestoreSubworkflowIDs(t *testing.T) {
+	prior := `[
+		{"name": "Call legacy", "type": "n8n-nodes-base.executeWorkflow", "parameters": {"workflowId": "dev1"}},
+		{"name": "Call enrich", "type": "n8n-nodes-base.executeWorkflow", "parameters": {"workflowId": {"__rl": true, "mode": "list", "value": "dev2"}}},
+		{"name": "Tool", "type": "@n8n/n8n-nodes-langchain.toolWorkflow", "parameters": {}}
+	]`
+	overrides := map[string]string{"Call legacy": "prod1", "Call enrich": "prod2", "Tool": "prod3"}
+
+	nodes := []n8n.Node{
+		{Name: "Call legacy", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "prod1"}},
+		{Name: "Call enrich", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": map[string]interface{}{"__rl": true, "mode": "id", "value": "other"}}},
+		{Name: "Tool", Type: "@n8n/n8n-nodes-langchain.toolWorkflow", Parameters: map[string]interface{}{"workflowId": map[string]interface{}{"__rl": true, "mode": "id", "value": "prod3"}}},
+	}
+	restoreSubworkflowIDs(nodes, prior, overrides)
+
+	assert.Equal(t, "dev1", nodes[0].Parameters["workflowId"])
+	// Changed outside Terraform, reported as drift
+	assert.Equal(t, "other", nodes[1].Parameters["workflowId"].(map[string]interface{})["value"])
+	assert.NotContains(t, nodes[2].Parameters, "workflowId")
+}
@@ -0,0 +1,174 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// defaultSweepPrefix is the name prefix used by acceptance tests for the
+// objects they create. It can be overridden with the N8N_SWEEP_PREFIX
+// environment variable.
+const defaultSweepPrefix = "tf-acc-"
+
+// TestMain wires the sweepers into "go test". Run them against a shared
+// instance configured through N8N_HOST and N8N_TOKEN with:
+//
+//	go test ./internal/provider -v -sweep=all
+//
+// Only workflows and tags are swept. The n8n public API cannot list
+// credentials, so credentials left behind by a test, which the provider does
+// not create itself, must be deleted by hand in the n8n editor.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("n8n_workflow", &resource.Sweeper{
+		Name: "n8n_workflow",
+		F:    sweepWorkflows,
+	})
+	// Tags are swept after the workflows carrying them
+	resource.AddTestSweepers("n8n_tag", &resource.Sweeper{
+		Name:         "n8n_tag",
+		Dependencies: []string{"n8n_workflow"},
+		F:            sweepTags,
+	})
+}
+
+// sweepPrefix returns the configured name prefix of objects to sweep.
+func sweepPrefix() string {
+	if prefix := os.Getenv("N8N_SWEEP_PREFIX"); prefix != "" {
+		return prefix
+	}
+	return defaultSweepPrefix
+}
+
+// sweeperClient creates an n8n client from the N8N_HOST and N8N_TOKEN
+// environment variables.
+func sweeperClient() (*n8n.Client, error) {
+	host := os.Getenv("N8N_HOST")
+	token := os.Getenv("N8N_TOKEN")
+	if host == "" || token == "" {
+		return nil, fmt.Errorf("N8N_HOST and N8N_TOKEN must be set to run sweepers")
+	}
+	return n8n.NewClient(&host, &token)
+}
+
+// sweepableWorkflows returns the workflows whose name starts with prefix.
+func sweepableWorkflows(workflows []n8n.Workflow, prefix string) []n8n.Workflow {
+	var matches []n8n.Workflow
+	for _, workflow := range workflows {
+		if strings.HasPrefix(workflow.Name, prefix) {
+			matches = append(matches, workflow)
+		}
+	}
+	return matches
+}
+
+// sweepWorkflows deletes every workflow left behind by acceptance tests.
+func sweepWorkflows(_ string) error {
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	workflows, err := client.GetWorkflows()
+	if err != nil {
+		return fmt.Errorf("error listing workflows: %w", err)
+	}
+
+	var errs []string
+	for _, workflow := range sweepableWorkflows(workflows.Data, sweepPrefix()) {
+		if _, err := client.DeleteWorkflow(workflow.ID); err != nil {
+			errs = append(errs, fmt.Sprintf("%s (%s): %s", workflow.Name, workflow.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error sweeping workflows:\n%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+// sweepableTags returns the tags whose name starts with prefix.
+func sweepableTags(tags []n8n.Tag, prefix string) []n8n.Tag {
+	var matches []n8n.Tag
+	for _, tag := range tags {
+		if strings.HasPrefix(tag.Name, prefix) {
+			matches = append(matches, tag)
+		}
+	}
+	return matches
+}
+
+// sweepTags deletes every tag left behind by acceptance tests.
+func sweepTags(_ string) error {
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	tags, err := client.GetTags()
+	if err != nil {
+		return fmt.Errorf("error listing tags: %w", err)
+	}
+
+	var errs []string
+	for _, tag := range sweepableTags(tags.Data, sweepPrefix()) {
+		if _, err := client.DeleteTag(tag.ID); err != nil {
+			errs = append(errs, fmt.Sprintf("%s (%s): %s", tag.Name, tag.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error sweeping tags:\n%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func TestSweepableWorkflows(t *testing.T) {
+	workflows := []n8n.Workflow{
+		{ID: "1", Name: "tf-acc-workflow"},
+		{ID: "2", Name: "Production workflow"},
+		{ID: "3", Name: "tf-acc-other"},
+		{ID: "4", Name: "my-tf-acc-workflow"},
+	}
+
+	matches := sweepableWorkflows(workflows, defaultSweepPrefix)
+
+	assert.Len(t, matches, 2)
+	assert.Equal(t, "1", matches[0].ID)
+	assert.Equal(t, "3", matches[1].ID)
+}
+
+func TestSweepableTags(t *testing.T) {
+	tags := []n8n.Tag{
+		{ID: "1", Name: "tf-acc-tag"},
+		{ID: "2", Name: "production"},
+		{ID: "3", Name: "my-tf-acc-tag"},
+	}
+
+	matches := sweepableTags(tags, defaultSweepPrefix)
+
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "1", matches[0].ID)
+}
+
+func TestSweepPrefix(t *testing.T) {
+	t.Setenv("N8N_SWEEP_PREFIX", "")
+	assert.Equal(t, defaultSweepPrefix, sweepPrefix())
+
+	t.Setenv("N8N_SWEEP_PREFIX", "ci-")
+	assert.Equal(t, "ci-", sweepPrefix())
+}
@@ -0,0 +1,269 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tagResource{}
+	_ resource.ResourceWithConfigure   = &tagResource{}
+	_ resource.ResourceWithImportState = &tagResource{}
+)
+
+// NewTagResource returns a new resource.
+func NewTagResource() resource.Resource {
+	return &tagResource{}
+}
+
+type tagResource struct {
+	client *n8n.Client
+}
+
+// tagResourceModel maps the resource schema data.
+type tagResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	ForceDetach   types.Bool   `tfsdk:"force_detach"`
+}
+
+func (r *tagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (r *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a workflow tag. Tag names are unique across the instance, so creating a tag that already exists fails unless it is adopted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the tag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the tag. Changing it renames the tag on every workflow using it.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On create, take over the tag with the same name if it exists, e.g. when it was created in the n8n editor. An adopted tag is deleted with the resource like any other.",
+			},
+			"force_detach": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, remove the tag from the workflows still carrying it before deleting it. By default, destroying a tag attached to workflows fails with the names of the workflows.",
+			},
+		},
+	}
+}
+
+func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	var plan tagResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tag *n8n.Tag
+	if plan.AdoptExisting.ValueBool() {
+		existing, err := tagNamed(r.client, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read n8n Tags", err.Error())
+			return
+		}
+		if existing != nil {
+			tflog.Debug(ctx, "Adopting existing tag", map[string]any{"id": existing.ID, "name": existing.Name})
+			tag = existing
+		}
+	}
+
+	if tag == nil {
+		created, err := r.client.CreateTag(&n8n.CreateTagRequest{Name: plan.Name.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating tag",
+				fmt.Sprintf("%s\n\nIf tag %q already exists, import it or set adopt_existing.", err, plan.Name.ValueString()),
+			)
+			return
+		}
+		tag = created
+	}
+
+	plan.ID = types.StringValue(tag.ID)
+	plan.Name = types.StringValue(tag.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tagResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.GetTag(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading tag", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(tag.ID)
+	state.Name = types.StringValue(tag.Name)
+
+	// Provider-only options are absent after import, fall back to their defaults
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+	if state.ForceDetach.IsNull() {
+		state.ForceDetach = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state tagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if !plan.Name.Equal(state.Name) {
+		tflog.Debug(ctx, "Renaming tag", map[string]any{"id": state.ID.ValueString()})
+
+		tag, err := r.client.UpdateTag(state.ID.ValueString(), &n8n.UpdateTagRequest{Name: plan.Name.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Error renaming tag", err.Error())
+			return
+		}
+		plan.Name = types.StringValue(tag.Name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	var state tagResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflows, err := r.client.GetWorkflows(n8n.WithTag(state.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	if len(workflows.Data) > 0 {
+		if !state.ForceDetach.ValueBool() {
+			resp.Diagnostics.AddError("Tag is attached to workflows", attachedTagDetail(state.Name.ValueString(), workflows.Data))
+			return
+		}
+		for _, workflow := range workflows.Data {
+			tflog.Debug(ctx, "Detaching tag from workflow", map[string]any{"tag": state.ID.ValueString(), "workflow": workflow.ID})
+			if err := detachTag(r.client, workflow, state.ID.ValueString()); err != nil {
+				resp.Diagnostics.AddError("Error detaching tag", fmt.Sprintf("Workflow %q (%s): %s", workflow.Name, workflow.ID, err))
+				return
+			}
+		}
+	}
+
+	if _, err := r.client.DeleteTag(state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting tag", err.Error())
+	}
+}
+
+func (r *tagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// tagNamed returns the tag with the given name, or nil if there is none.
+func tagNamed(client *n8n.Client, name string) (*n8n.Tag, error) {
+	tags, err := client.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags.Data {
+		if tag.Name == name {
+			return &tag, nil
+		}
+	}
+	return nil, nil
+}
+
+// attachedTagDetail describes why a tag attached to workflows is not deleted.
+func attachedTagDetail(name string, workflows []n8n.Workflow) string {
+	names := make([]string, len(workflows))
+	for i, workflow := range workflows {
+		names[i] = fmt.Sprintf("%q (%s)", workflow.Name, workflow.ID)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("Tag %q is still attached to %d workflows: %s. Remove the tag from them first, or set force_detach to remove it on destroy.", name, len(workflows), strings.Join(names, ", "))
+}
+
+// detachTag removes the tag with the given ID from the tags of a workflow.
+func detachTag(client *n8n.Client, workflow n8n.Workflow, tagID string) error {
+	references := []n8n.TagReference{}
+	for _, tag := range workflow.Tags {
+		if tag.ID != tagID {
+			references = append(references, n8n.TagReference{ID: tag.ID})
+		}
+	}
+	_, err := client.UpdateWorkflowTags(workflow.ID, references)
+	return err
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagNamed(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/tags", http.StatusOK, `{"data": [{"id": "1", "name": "production"}, {"id": "2", "name": "Production"}], "nextCursor": null}`)
+	client := server.Client()
+
+	tag, err := tagNamed(client, "Production")
+	require.NoError(t, err)
+	require.NotNil(t, tag)
+	assert.Equal(t, "2", tag.ID, "names are matched exactly")
+
+	tag, err = tagNamed(client, "staging")
+	require.NoError(t, err)
+	assert.Nil(t, tag)
+}
+
+func TestDetachTag(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("PUT /api/v1/workflows/1/tags", http.StatusOK, `[{"id": "3", "name": "billing"}]`)
+
+	workflow := n8ntest.Workflow("1", "Sync orders")
+	workflow.Tags = []n8n.Tag{{ID: "2", Name: "deprecated"}, {ID: "3", Name: "billing"}}
+	require.NoError(t, detachTag(server.Client(), workflow, "2"))
+
+	requests := server.Requests("PUT /api/v1/workflows/1/tags")
+	require.Len(t, requests, 1)
+	var references []n8n.TagReference
+	requests[0].DecodeBody(t, &references)
+	assert.Equal(t, []n8n.TagReference{{ID: "3"}}, references)
+}
+
+func TestAttachedTagDetail(t *testing.T) {
+	detail := attachedTagDetail("deprecated", []n8n.Workflow{
+		n8ntest.Workflow("2", "Sync orders"),
+		n8ntest.Workflow("1", "Billing"),
+	})
+	assert.Equal(t, `Tag "deprecated" is still attached to 2 workflows: "Billing" (1), "Sync orders" (2). Remove the tag from them first, or set force_detach to remove it on destroy.`, detail)
+}
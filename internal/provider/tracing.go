@@ -0,0 +1,54 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans of the provider.
+const tracerName = "github.com/arthurbdiniz/terraform-provider-n8n"
+
+// newTracer returns a tracer exporting spans to the OTLP/HTTP endpoint at the
+// given URL, e.g. http://localhost:4318. Spans are exported as soon as they
+// end, since Terraform stops the provider process without notice once it is
+// done with it.
+func newTracer(ctx context.Context, endpoint string) (trace.Tracer, error) {
+	// The exporter ignores invalid URLs, falling back to its default endpoint
+	parsed, err := url.Parse(endpoint)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("%q is not an http or https URL", endpoint)
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(sdkresource.NewSchemaless(attribute.String("service.name", "terraform-provider-n8n"))),
+	)
+	return tracerProvider.Tracer(tracerName), nil
+}
+
+// traceParentFromEnv returns the span context of the W3C traceparent found
+// in the TRACEPARENT environment variable, which CI tools set to link the
+// spans of a job together. The span context is invalid when the variable is
+// not set.
+func traceParentFromEnv() trace.SpanContext {
+	carrier := propagation.MapCarrier{"traceparent": os.Getenv("TRACEPARENT")}
+	ctx := propagation.TraceContext{}.Extract(context.Background(), carrier)
+	return trace.SpanContextFromContext(ctx)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceParentFromEnv(t *testing.T) {
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	parent := traceParentFromEnv()
+	require.True(t, parent.IsValid())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", parent.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", parent.SpanID().String())
+
+	t.Setenv("TRACEPARENT", "")
+	assert.False(t, traceParentFromEnv().IsValid())
+}
+
+func TestNewTracer(t *testing.T) {
+	tracer, err := newTracer(context.Background(), "http://localhost:4318")
+	require.NoError(t, err)
+	assert.NotNil(t, tracer)
+
+	_, err = newTracer(context.Background(), "://invalid")
+	assert.Error(t, err)
+}
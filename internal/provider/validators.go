@@ -0,0 +1,79 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonPointersValidator validates that every element of a list of strings is
+// a JSON pointer accepted by parseJSONPointer.
+type jsonPointersValidator struct{}
+
+// JSONPointers returns a list validator checking that all elements are JSON pointers.
+func JSONPointers() validator.List {
+	return jsonPointersValidator{}
+}
+
+func (v jsonPointersValidator) Description(_ context.Context) string {
+	return "Each element must be a JSON pointer such as \"/*/parameters/options\"."
+}
+
+func (v jsonPointersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonPointersValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		pointer, ok := element.(types.String)
+		if !ok || pointer.IsNull() || pointer.IsUnknown() {
+			continue
+		}
+
+		if _, err := parseJSONPointer(pointer.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid JSON Pointer", err.Error())
+		}
+	}
+}
+
+// stringOneOfValidator validates that a string is one of a fixed set of values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// StringOneOf returns a string validator checking that the value is one of values.
+func StringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be one of: %s.", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, value := range v.values {
+		if req.ConfigValue.ValueString() == value {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("%s Got: %q.", v.Description(ctx), req.ConfigValue.ValueString()))
+}
@@ -0,0 +1,36 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringOneOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		expectErr bool
+	}{
+		{name: "allowed", value: types.StringValue("v0")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "not allowed", value: types.StringValue("v2"), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("execution_order"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			StringOneOf("v0", "v1").ValidateString(context.Background(), req, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// variablesResourceID is the ID of the single set of variables of an instance.
+const variablesResourceID = "variables"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &variablesResource{}
+	_ resource.ResourceWithConfigure   = &variablesResource{}
+	_ resource.ResourceWithImportState = &variablesResource{}
+)
+
+// NewVariablesResource returns a new resource.
+func NewVariablesResource() resource.Resource {
+	return &variablesResource{}
+}
+
+type variablesResource struct {
+	client *n8n.Client
+}
+
+// variablesResourceModel maps the resource schema data.
+type variablesResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Variables types.Map    `tfsdk:"variables"`
+}
+
+// variableChangeSet lists the API calls reconciling the variables of an
+// instance with the configured ones.
+type variableChangeSet struct {
+	create []n8n.CreateVariableRequest
+	update []n8n.Variable
+	delete []n8n.Variable
+}
+
+func (r *variablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+func (r *variablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+func (r *variablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the full set of variables of the instance, available to workflows as `$vars`. Variables are read with a single paginated request, and only the variables that differ are written. Variables missing from the configuration are deleted, including those created in the n8n editor, so use a single n8n_variables resource per instance. Destroying the resource deletes the variables it manages.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always `variables`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Values of the variables of the instance, by key.",
+			},
+		},
+	}
+}
+
+func (r *variablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	var plan variablesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *variablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state variablesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := r.client.GetVariables()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading variables", err)
+		return
+	}
+
+	values := make(map[string]string, len(variables.Data))
+	for _, variable := range variables.Data {
+		values[variable.Key] = variable.Value
+	}
+
+	state.ID = types.StringValue(variablesResourceID)
+	state.Variables, diags = types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *variablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	var plan variablesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *variablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	var state variablesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed map[string]string
+	resp.Diagnostics.Append(state.Variables.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := r.client.GetVariables()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading variables", err)
+		return
+	}
+
+	for _, variable := range variables.Data {
+		if _, ok := managed[variable.Key]; !ok {
+			continue
+		}
+		if err := r.client.DeleteVariable(variable.ID); err != nil {
+			resp.Diagnostics.AddError("Error deleting variable", fmt.Sprintf("Variable %q: %s", variable.Key, err))
+		}
+	}
+}
+
+func (r *variablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply creates, updates and deletes variables until the variables of the
+// instance match the planned ones.
+func (r *variablesResource) apply(ctx context.Context, plan *variablesResourceModel, diagnostics *diag.Diagnostics) {
+	var desired map[string]string
+	diagnostics.Append(plan.Variables.ElementsAs(ctx, &desired, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	variables, err := r.client.GetVariables()
+	if err != nil {
+		addAPIError(diagnostics, "Error reading variables", err)
+		return
+	}
+
+	changes := variableChanges(variables.Data, desired)
+	tflog.Debug(ctx, "Reconciling variables", map[string]any{
+		"create": len(changes.create),
+		"update": len(changes.update),
+		"delete": len(changes.delete),
+	})
+
+	// Delete first so that removed keys can be reused by new variables
+	for _, variable := range changes.delete {
+		if err := r.client.DeleteVariable(variable.ID); err != nil {
+			diagnostics.AddError("Error deleting variable", fmt.Sprintf("Variable %q: %s", variable.Key, err))
+		}
+	}
+	for _, variable := range changes.update {
+		if err := r.client.UpdateVariable(variable.ID, &n8n.UpdateVariableRequest{Key: variable.Key, Value: variable.Value}); err != nil {
+			diagnostics.AddError("Error updating variable", fmt.Sprintf("Variable %q: %s", variable.Key, err))
+		}
+	}
+	for _, request := range changes.create {
+		if err := r.client.CreateVariable(&request); err != nil {
+			diagnostics.AddError("Error creating variable", fmt.Sprintf("Variable %q: %s", request.Key, err))
+		}
+	}
+
+	plan.ID = types.StringValue(variablesResourceID)
+}
+
+// variableChanges compares the variables of an instance with the desired
+// values by key. Updated variables carry their new value. Each list is sorted
+// by key.
+func variableChanges(current []n8n.Variable, desired map[string]string) variableChangeSet {
+	var changes variableChangeSet
+	existing := make(map[string]bool, len(current))
+
+	for _, variable := range current {
+		existing[variable.Key] = true
+		value, ok := desired[variable.Key]
+		switch {
+		case !ok:
+			changes.delete = append(changes.delete, variable)
+		case value != variable.Value:
+			variable.Value = value
+			changes.update = append(changes.update, variable)
+		}
+	}
+
+	for key, value := range desired {
+		if !existing[key] {
+			changes.create = append(changes.create, n8n.CreateVariableRequest{Key: key, Value: value})
+		}
+	}
+
+	sort.Slice(changes.create, func(i, j int) bool { return changes.create[i].Key < changes.create[j].Key })
+	sort.Slice(changes.update, func(i, j int) bool { return changes.update[i].Key < changes.update[j].Key })
+	sort.Slice(changes.delete, func(i, j int) bool { return changes.delete[i].Key < changes.delete[j].Key })
+	return changes
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariableChanges(t *testing.T) {
+	current := []n8n.Variable{
+		{ID: "1", Key: "REGION", Value: "eu"},
+		{ID: "2", Key: "TIER", Value: "staging"},
+		{ID: "3", Key: "LEGACY", Value: "yes"},
+	}
+	desired := map[string]string{
+		"REGION":  "eu",
+		"TIER":    "prod",
+		"OWNER":   "platform",
+		"CONTACT": "oncall@example.com",
+	}
+
+	changes := variableChanges(current, desired)
+	assert.Equal(t, []n8n.CreateVariableRequest{
+		{Key: "CONTACT", Value: "oncall@example.com"},
+		{Key: "OWNER", Value: "platform"},
+	}, changes.create)
+	assert.Equal(t, []n8n.Variable{{ID: "2", Key: "TIER", Value: "prod"}}, changes.update)
+	assert.Equal(t, []n8n.Variable{{ID: "3", Key: "LEGACY", Value: "yes"}}, changes.delete)
+
+	changes = variableChanges(current, map[string]string{"REGION": "eu", "TIER": "staging", "LEGACY": "yes"})
+	assert.Empty(t, changes.create)
+	assert.Empty(t, changes.update)
+	assert.Empty(t, changes.delete)
+}
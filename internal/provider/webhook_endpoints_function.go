@@ -0,0 +1,59 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &webhookEndpointsFunction{}
+
+// NewWebhookEndpointsFunction returns a new function.
+func NewWebhookEndpointsFunction() function.Function {
+	return &webhookEndpointsFunction{}
+}
+
+type webhookEndpointsFunction struct{}
+
+func (f *webhookEndpointsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_endpoints"
+}
+
+func (f *webhookEndpointsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the webhook endpoints of workflow nodes",
+		Description: "Parses a JSON-encoded array of workflow nodes and returns the sorted HTTP methods and paths their enabled Webhook nodes listen on, as \"METHOD path\". Paths set by an expression are skipped. Useful to detect webhook path collisions between the workflows of a configuration with a check block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "nodes",
+				Description: "JSON-encoded array of workflow nodes, as in the nodes attribute of n8n_workflow.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *webhookEndpointsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var nodesJSON string
+	resp.Error = req.Arguments.Get(ctx, &nodesJSON)
+	if resp.Error != nil {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(nodesJSON), &nodes); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid nodes JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, webhookEndpoints(nodes))
+}
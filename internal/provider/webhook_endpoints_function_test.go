@@ -0,0 +1,45 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookEndpointsFunction(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`[
+			{"name": "Orders", "type": "n8n-nodes-base.webhook", "parameters": {"path": "/orders", "httpMethod": "POST"}},
+			{"name": "Status", "type": "n8n-nodes-base.webhook", "parameters": {"path": "status"}},
+			{"name": "Dynamic", "type": "n8n-nodes-base.webhook", "parameters": {"path": "={{ $env.PATH }}"}},
+			{"name": "Wait", "type": "n8n-nodes-base.wait"}
+		]`)}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	(&webhookEndpointsFunction{}).Run(context.Background(), req, &resp)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("GET status"),
+		types.StringValue("POST orders"),
+	}), resp.Result.Value())
+}
+
+func TestWebhookEndpointsFunctionInvalidJSON(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`{"name": "Orders"}`)}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	(&webhookEndpointsFunction{}).Run(context.Background(), req, &resp)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Error(), "Invalid nodes JSON")
+}
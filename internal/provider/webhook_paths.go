@@ -0,0 +1,190 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// webhookNodeType is the type of the node listening on a webhook path.
+const webhookNodeType = "n8n-nodes-base.webhook"
+
+// webhookReadyTimeout bounds the time waitForWebhooks waits for n8n to serve
+// the webhooks of an activated workflow.
+var webhookReadyTimeout = time.Minute
+
+// webhookReadyInterval is the delay between two checks of a webhook that is
+// not served yet.
+var webhookReadyInterval = time.Second
+
+// webhookEndpoints returns the sorted HTTP methods and paths the enabled
+// Webhook nodes listen on, as "METHOD path". Paths set by an expression are
+// skipped since they are only known at run time.
+func webhookEndpoints(nodes []n8n.Node) []string {
+	seen := make(map[string]bool)
+	endpoints := []string{}
+	for _, node := range nodes {
+		if node.Type != webhookNodeType || node.Disabled {
+			continue
+		}
+
+		webhookPath, _ := node.Parameters["path"].(string)
+		webhookPath = strings.Trim(webhookPath, "/")
+		if webhookPath == "" || strings.HasPrefix(webhookPath, "=") {
+			continue
+		}
+
+		for _, method := range webhookMethods(node.Parameters["httpMethod"]) {
+			endpoint := method + " " + webhookPath
+			if !seen[endpoint] {
+				seen[endpoint] = true
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// webhookMethods returns the HTTP methods of a Webhook node httpMethod
+// parameter, a list when the node accepts multiple methods. GET is the
+// default.
+func webhookMethods(value interface{}) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{strings.ToUpper(value)}
+	case []interface{}:
+		var methods []string
+		for _, method := range value {
+			if method, ok := method.(string); ok {
+				methods = append(methods, strings.ToUpper(method))
+			}
+		}
+		return methods
+	default:
+		return []string{"GET"}
+	}
+}
+
+// webhookCollisions describes each endpoint of the given list that an active
+// workflow other than the one with excludeID already listens on.
+func webhookCollisions(endpoints []string, workflows []n8n.Workflow, excludeID string) []string {
+	wanted := make(map[string]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		wanted[endpoint] = true
+	}
+
+	var collisions []string
+	for _, workflow := range workflows {
+		if !workflow.Active || workflow.ID == excludeID {
+			continue
+		}
+		for _, endpoint := range webhookEndpoints(workflow.Nodes) {
+			if wanted[endpoint] {
+				collisions = append(collisions, fmt.Sprintf("%s, which active workflow %q (%s) already listens on", endpoint, workflow.Name, workflow.ID))
+			}
+		}
+	}
+	sort.Strings(collisions)
+	return collisions
+}
+
+// warnAboutWebhookCollisions warns when an active workflow about to be
+// written listens on a webhook path that another active workflow of the
+// instance already uses, in which case n8n refuses to activate it.
+func (r *workflowResource) warnAboutWebhookCollisions(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan workflowResourceModel
+	if diags := resp.Plan.Get(ctx, &plan); diags.HasError() || !plan.Active.ValueBool() || plan.Nodes.IsUnknown() || plan.ReadOnly.ValueBool() {
+		return
+	}
+
+	// Only check workflows being activated or whose nodes change
+	if !req.State.Raw.IsNull() {
+		var state workflowResourceModel
+		if diags := req.State.Get(ctx, &state); diags.HasError() || (state.Active.ValueBool() && plan.Nodes.Equal(state.Nodes)) {
+			return
+		}
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+	endpoints := webhookEndpoints(nodes)
+	if len(endpoints) == 0 {
+		return
+	}
+
+	if plan.Endpoint != nil && (plan.Endpoint.Host.IsUnknown() || plan.Endpoint.Token.IsUnknown()) {
+		return
+	}
+	client, err := r.clientFor(ctx, plan.Endpoint)
+	if err != nil || client == nil {
+		return
+	}
+
+	workflows, err := client.GetWorkflows()
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check for webhook path collisions", map[string]any{"error": err.Error()})
+		return
+	}
+
+	for _, collision := range webhookCollisions(endpoints, workflows.Data, plan.ID.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("nodes"),
+			"Webhook path already in use",
+			fmt.Sprintf("Workflow %q listens on %s. n8n will refuse to activate it until one of the two workflows changes its webhook path or is deactivated.", plan.Name.ValueString(), collision),
+		)
+	}
+}
+
+// waitForWebhooks polls the production URL of each webhook path of an active
+// workflow until n8n serves it, or returns an error once webhookReadyTimeout
+// has passed. Paths with route parameters, such as "orders/:id", are skipped
+// since they only match concrete URLs.
+func waitForWebhooks(ctx context.Context, client *n8n.Client, workflow *n8n.Workflow) error {
+	deadline := time.Now().Add(webhookReadyTimeout)
+
+	seen := make(map[string]bool)
+	for _, endpoint := range webhookEndpoints(workflow.Nodes) {
+		_, webhookPath, _ := strings.Cut(endpoint, " ")
+		if seen[webhookPath] || strings.Contains(webhookPath, ":") {
+			continue
+		}
+		seen[webhookPath] = true
+
+		for {
+			registered, err := client.WebhookRegistered(webhookPath)
+			if err == nil && registered {
+				break
+			}
+
+			tflog.Debug(ctx, "Webhook not served yet", map[string]any{"id": workflow.ID, "path": webhookPath, "error": err})
+
+			if time.Now().After(deadline) {
+				if err != nil {
+					return fmt.Errorf("unable to check webhook %q of workflow %s: %w", webhookPath, workflow.ID, err)
+				}
+				return fmt.Errorf("n8n did not serve webhook %q of workflow %s within %s of activating it. Check that the webhooks of the instance are served on the provider host", webhookPath, workflow.ID, webhookReadyTimeout)
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(webhookReadyInterval):
+			}
+		}
+	}
+	return nil
+}
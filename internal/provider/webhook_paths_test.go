@@ -0,0 +1,82 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookEndpoints(t *testing.T) {
+	nodes := []n8n.Node{
+		{Type: webhookNodeType, Parameters: map[string]interface{}{"path": "/orders/", "httpMethod": "post"}},
+		{Type: webhookNodeType, Parameters: map[string]interface{}{"path": "status"}},
+		{Type: webhookNodeType, Parameters: map[string]interface{}{"path": "items", "multipleMethods": true, "httpMethod": []interface{}{"GET", "DELETE"}}},
+		{Type: webhookNodeType, Parameters: map[string]interface{}{"path": "={{ $env.PATH }}"}},
+		{Type: webhookNodeType, Disabled: true, Parameters: map[string]interface{}{"path": "old"}},
+		{Type: "n8n-nodes-base.set", Parameters: map[string]interface{}{"path": "ignored"}},
+	}
+
+	assert.Equal(t, []string{"DELETE items", "GET items", "GET status", "POST orders"}, webhookEndpoints(nodes))
+}
+
+func TestWebhookCollisions(t *testing.T) {
+	webhook := func(path string) []n8n.Node {
+		return []n8n.Node{{Type: webhookNodeType, Parameters: map[string]interface{}{"path": path, "httpMethod": "POST"}}}
+	}
+	workflows := []n8n.Workflow{
+		{ID: "wf1", Name: "Orders", Active: true, Nodes: webhook("orders")},
+		{ID: "wf2", Name: "Orders copy", Active: true, Nodes: webhook("orders")},
+		{ID: "wf3", Name: "Draft", Active: false, Nodes: webhook("orders")},
+	}
+
+	collisions := webhookCollisions([]string{"POST orders"}, workflows, "wf1")
+	require.Len(t, collisions, 1)
+	assert.Contains(t, collisions[0], `"Orders copy" (wf2)`)
+
+	assert.Empty(t, webhookCollisions([]string{"GET orders"}, workflows, "wf1"))
+}
+
+func TestWaitForWebhooks(t *testing.T) {
+	webhookReadyInterval = time.Millisecond
+	t.Cleanup(func() { webhookReadyInterval = time.Second })
+
+	checks := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checks[r.URL.Path]++
+		// The orders webhook is registered on the third check
+		if r.URL.Path == "/webhook/orders" && checks[r.URL.Path] >= 3 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow := &n8n.Workflow{ID: "wf1", Active: true, Nodes: []n8n.Node{
+		{Name: "Orders", Type: webhookNodeType, Parameters: map[string]interface{}{"path": "orders", "httpMethod": []interface{}{"GET", "POST"}}},
+		{Name: "Order", Type: webhookNodeType, Parameters: map[string]interface{}{"path": "orders/:id"}},
+	}}
+	require.NoError(t, waitForWebhooks(context.Background(), client, workflow))
+	assert.Equal(t, map[string]int{"/webhook/orders": 3}, checks, "each path is checked once it is served, route parameters are skipped")
+
+	webhookReadyTimeout = 10 * time.Millisecond
+	t.Cleanup(func() { webhookReadyTimeout = time.Minute })
+
+	workflow.Nodes = []n8n.Node{{Name: "Missing", Type: webhookNodeType, Parameters: map[string]interface{}{"path": "missing"}}}
+	err = waitForWebhooks(context.Background(), client, workflow)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `webhook "missing" of workflow wf1`)
+}
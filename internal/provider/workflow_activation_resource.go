@@ -0,0 +1,319 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &workflowActivationResource{}
+	_ resource.ResourceWithConfigure  = &workflowActivationResource{}
+	_ resource.ResourceWithModifyPlan = &workflowActivationResource{}
+)
+
+// NewWorkflowActivationResource returns a new resource.
+func NewWorkflowActivationResource() resource.Resource {
+	return &workflowActivationResource{}
+}
+
+type workflowActivationResource struct {
+	client *n8n.Client
+}
+
+// workflowActivationResourceModel maps the resource schema data.
+type workflowActivationResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	Tags                  types.List   `tfsdk:"tags"`
+	Active                types.Bool   `tfsdk:"active"`
+	RestoreOnDestroy      types.Bool   `tfsdk:"restore_on_destroy"`
+	ChangedWorkflowIDs    types.List   `tfsdk:"changed_workflow_ids"`
+	MismatchedWorkflowIDs types.List   `tfsdk:"mismatched_workflow_ids"`
+}
+
+func (r *workflowActivationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+func (r *workflowActivationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_activation"
+}
+
+func (r *workflowActivationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Activates or deactivates all workflows matching a selector by project and tags, for example to pause scheduled workflows during a maintenance window. Workflows whose state drifted are reported in the plan and switched again on apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the resource, derived from its selector.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only switch workflows of the project with this ID. Requires an n8n instance licensed for projects. When omitted, workflows of every project match.",
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only switch workflows that have all of these tags. When both project_id and tags are omitted or empty, every workflow matches.",
+			},
+			"active": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the matching workflows should be active.",
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to switch the workflows changed by this resource back when it is destroyed, ending the maintenance window. Defaults to true.",
+			},
+			"changed_workflow_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the workflows this resource activated or deactivated, switched back on destroy when restore_on_destroy is set.",
+			},
+			"mismatched_workflow_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the matching workflows whose state differs from active. Always empty after apply.",
+			},
+		},
+	}
+}
+
+func (r *workflowActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowActivationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.enforce(ctx, &plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workflowActivationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagNames, workflows, err := r.matchingWorkflows(ctx, state.ProjectID, state.Tags)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	mismatched := []string{}
+	for _, workflow := range workflows {
+		if workflow.Active != state.Active.ValueBool() {
+			mismatched = append(mismatched, workflow.ID)
+		}
+	}
+
+	state.ID = types.StringValue(workflowSelectorID(state.ProjectID.ValueString(), tagNames))
+	state.MismatchedWorkflowIDs, diags = types.ListValueFrom(ctx, types.StringType, mismatched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state workflowActivationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Workflows changed under the same active value are still to be restored.
+	// When active flipped, they are back to their original state.
+	var previouslyChanged []string
+	if plan.Active.Equal(state.Active) {
+		resp.Diagnostics.Append(state.ChangedWorkflowIDs.ElementsAs(ctx, &previouslyChanged, false)...)
+	}
+
+	r.enforce(ctx, &plan, previouslyChanged, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowActivationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	var changed []string
+	resp.Diagnostics.Append(state.ChangedWorkflowIDs.ElementsAs(ctx, &changed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range changed {
+		tflog.Debug(ctx, "Restoring workflow activation", map[string]any{"id": id})
+
+		if err := setWorkflowActive(r.client, id, !state.Active.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error restoring workflow activation", fmt.Sprintf("Workflow %s: %s", id, err))
+			return
+		}
+	}
+}
+
+// ModifyPlan plans an update whenever a matching workflow is not in the
+// desired state, so that it is switched again on apply.
+func (r *workflowActivationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mismatched_workflow_ids"), types.ListValueMust(types.StringType, nil))...)
+
+	var plan workflowActivationResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ProjectID.IsUnknown() && !plan.Tags.IsUnknown() {
+		var tagNames []string
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tagNames, false)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringValue(workflowSelectorID(plan.ProjectID.ValueString(), tagNames)))...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state workflowActivationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list of changed workflows is only known after apply when workflows
+	// are going to be switched
+	changedWorkflowIDs := state.ChangedWorkflowIDs
+	if len(state.MismatchedWorkflowIDs.Elements()) > 0 || !plan.Active.Equal(state.Active) || !plan.ProjectID.Equal(state.ProjectID) || !plan.Tags.Equal(state.Tags) {
+		changedWorkflowIDs = types.ListUnknown(types.StringType)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changed_workflow_ids"), changedWorkflowIDs)...)
+}
+
+// enforce activates or deactivates every matching workflow that is not in the
+// desired state, and records them along with previouslyChanged.
+func (r *workflowActivationResource) enforce(ctx context.Context, plan *workflowActivationResourceModel, previouslyChanged []string, diagnostics *diag.Diagnostics) {
+	tagNames, workflows, err := r.matchingWorkflows(ctx, plan.ProjectID, plan.Tags)
+	if err != nil {
+		diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	changed := append([]string{}, previouslyChanged...)
+	for _, workflow := range workflows {
+		if workflow.Active == plan.Active.ValueBool() {
+			continue
+		}
+
+		tflog.Debug(ctx, "Switching workflow activation", map[string]any{"id": workflow.ID, "active": plan.Active.ValueBool()})
+
+		if err := setWorkflowActive(r.client, workflow.ID, plan.Active.ValueBool()); err != nil {
+			diagnostics.AddError("Error switching workflow activation", fmt.Sprintf("Workflow %s: %s", workflow.ID, err))
+			return
+		}
+		changed = appendUnique(changed, workflow.ID)
+	}
+
+	var diags diag.Diagnostics
+	plan.ID = types.StringValue(workflowSelectorID(plan.ProjectID.ValueString(), tagNames))
+	plan.MismatchedWorkflowIDs = types.ListValueMust(types.StringType, nil)
+	plan.ChangedWorkflowIDs, diags = types.ListValueFrom(ctx, types.StringType, changed)
+	diagnostics.Append(diags...)
+}
+
+// matchingWorkflows returns the tags of the selector and the workflows it
+// matches.
+func (r *workflowActivationResource) matchingWorkflows(ctx context.Context, projectID types.String, tags types.List) ([]string, []n8n.Workflow, error) {
+	var tagNames []string
+	if diags := tags.ElementsAs(ctx, &tagNames, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("unable to read tags")
+	}
+
+	workflows, err := projectWorkflowsWithAllTags(r.client, projectID.ValueString(), tagNames)
+	return tagNames, workflows, err
+}
+
+// setWorkflowActive activates or deactivates a workflow.
+func setWorkflowActive(client *n8n.Client, workflowID string, active bool) error {
+	var err error
+	if active {
+		_, err = client.ActivateWorkflow(workflowID)
+	} else {
+		_, err = client.DeactivateWorkflow(workflowID)
+	}
+	return err
+}
+
+// appendUnique appends value to values unless it is already present.
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowActivationEnforce(t *testing.T) {
+	var deactivated []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workflows":
+			_, _ = w.Write([]byte(`{"data": [
+				{"id": "wf1", "active": true, "tags": [{"id": "1", "name": "nightly-batch"}]},
+				{"id": "wf2", "active": false, "tags": [{"id": "1", "name": "nightly-batch"}]},
+				{"id": "wf3", "active": true, "tags": []}
+			], "nextCursor": null}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/workflows/wf1/deactivate":
+			deactivated = append(deactivated, "wf1")
+			_, _ = w.Write([]byte(`{"id": "wf1", "active": false}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	r := &workflowActivationResource{client: client}
+	plan := workflowActivationResourceModel{
+		Tags:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nightly-batch")}),
+		Active: types.BoolValue(false),
+	}
+
+	var diags diag.Diagnostics
+	r.enforce(context.Background(), &plan, []string{"wf0"}, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{"wf1"}, deactivated, "only active workflows with the tag are deactivated")
+	assert.Equal(t, "tags:nightly-batch", plan.ID.ValueString())
+	assert.Empty(t, plan.MismatchedWorkflowIDs.Elements())
+
+	var changed []string
+	require.False(t, plan.ChangedWorkflowIDs.ElementsAs(context.Background(), &changed, false).HasError())
+	assert.Equal(t, []string{"wf0", "wf1"}, changed, "previously changed workflows are kept")
+}
+
+func TestWorkflowActivationEnforceInProject(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	var projectIDs []string
+	server.Handle("GET /api/v1/workflows", func(w http.ResponseWriter, r *http.Request) {
+		projectIDs = append(projectIDs, r.URL.Query().Get("projectId"))
+		_, _ = w.Write([]byte(`{"data": [{"id": "wf1", "active": false, "tags": []}]}`))
+	})
+	server.Respond("POST /api/v1/workflows/wf1/activate", http.StatusOK, `{"id": "wf1", "active": true}`)
+
+	r := &workflowActivationResource{client: server.Client()}
+	plan := workflowActivationResourceModel{
+		ProjectID: types.StringValue("p1"),
+		Tags:      types.ListNull(types.StringType),
+		Active:    types.BoolValue(true),
+	}
+
+	var diags diag.Diagnostics
+	r.enforce(context.Background(), &plan, nil, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{"p1"}, projectIDs, "workflows are listed within the project")
+	assert.Len(t, server.Requests("POST /api/v1/workflows/wf1/activate"), 1)
+	assert.Equal(t, "project:p1", plan.ID.ValueString())
+}
+
+func TestAppendUnique(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, appendUnique([]string{"a"}, "b"))
+	assert.Equal(t, []string{"a"}, appendUnique([]string{"a"}, "a"))
+}
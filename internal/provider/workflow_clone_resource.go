@@ -0,0 +1,379 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &workflowCloneResource{}
+	_ resource.ResourceWithConfigure = &workflowCloneResource{}
+)
+
+// NewWorkflowCloneResource returns a new resource.
+func NewWorkflowCloneResource() resource.Resource {
+	return &workflowCloneResource{}
+}
+
+type workflowCloneResource struct {
+	client        *n8n.Client
+	workflowNames workflowNamePolicy
+	managedTag    string
+}
+
+// workflowCloneResourceModel maps the resource schema data.
+type workflowCloneResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	SourceWorkflowID types.String `tfsdk:"source_workflow_id"`
+	Name             types.String `tfsdk:"name"`
+	Active           types.Bool   `tfsdk:"active"`
+	Substitutions    types.Map    `tfsdk:"substitutions"`
+	VersionId        types.String `tfsdk:"version_id"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+func (r *workflowCloneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+	r.workflowNames = data.workflowNames
+	r.managedTag = data.managedTag
+}
+
+func (r *workflowCloneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_clone"
+}
+
+func (r *workflowCloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a copy of an existing n8n workflow, such as a per-customer copy of a template workflow. The nodes, connections, settings, static data and pinned data are copied once on create; later changes to the source workflow are not propagated.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the cloned workflow.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_workflow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the workflow to copy. Changing it creates a new copy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the cloned workflow, without the provider `workflow_name_prefix` and `workflow_name_suffix`.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the cloned workflow is active.",
+			},
+			"substitutions": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Strings to replace in the node parameters of the source workflow, e.g. `{ \"__CUSTOMER__\" = \"acme\" }`. Longer strings are replaced first. Changing substitutions creates a new copy.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Workflow version ID. Changes on every workflow update.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the workflow was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the workflow was last updated.",
+			},
+		},
+	}
+}
+
+func (r *workflowCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowCloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	substitutions := map[string]string{}
+	resp.Diagnostics.Append(plan.Substitutions.ElementsAs(ctx, &substitutions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := r.client.GetWorkflow(plan.SourceWorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading source workflow", err.Error())
+		return
+	}
+
+	createReq := &n8n.CreateWorkflowRequest{
+		Name:        r.workflowNames.apply(plan.Name.ValueString()),
+		Nodes:       substituteNodeParameters(source.Nodes, substitutions),
+		Connections: source.Connections,
+		Settings:    source.Settings,
+		Extra:       source.WritableExtra(),
+	}
+
+	tflog.Debug(ctx, "Cloning workflow", map[string]any{"source": source.ID, "name": plan.Name.ValueString()})
+
+	workflow, err := r.client.CreateWorkflow(createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating workflow", err.Error())
+		return
+	}
+
+	// Save the created workflow, inactive as created, before activating it,
+	// so that it is tracked even if the activation fails and the next apply
+	// only retries the activation instead of copying it again
+	created := plan
+	workflowCloneToState(workflow, &created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Activate the workflow as requested, reporting a failure once the
+	// workflow is saved in state
+	var activationErr error
+	if plan.Active.ValueBool() {
+		var activated *n8n.Workflow
+		activated, activationErr = r.client.ActivateWorkflow(workflow.ID)
+		if activationErr == nil {
+			workflow = activated
+		}
+	}
+
+	// Mark the workflow as managed by Terraform
+	if r.managedTag != "" {
+		if err := tagWorkflowAsManaged(r.client, workflow, r.managedTag); err != nil {
+			resp.Diagnostics.AddWarning("Unable to tag workflow as managed", err.Error())
+		}
+	}
+
+	// Read the workflow back, including its tags, once the instance serves the writes
+	workflow = readWorkflowAfterWrite(ctx, r.client, workflow)
+
+	workflowCloneToState(workflow, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if activationErr != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("active"),
+			"Error activating workflow",
+			fmt.Sprintf("%s\n\nThe workflow was created and saved in state; only the activation is retried on the next apply.", activationErr),
+		)
+	}
+}
+
+func (r *workflowCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workflowCloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.GetWorkflow(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workflow", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(r.workflowNames.strip(workflow.Name))
+	state.Active = types.BoolValue(workflow.Active)
+	state.VersionId = types.StringValue(workflow.VersionId)
+	state.CreatedAt = types.StringValue(workflow.CreatedAt)
+	state.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state workflowCloneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.GetWorkflow(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workflow", err.Error())
+		return
+	}
+
+	// Only the name and the activation state are managed after the copy
+	if !plan.Name.Equal(state.Name) {
+		tflog.Debug(ctx, "Renaming cloned workflow", map[string]any{"id": workflow.ID})
+
+		workflow, err = r.client.UpdateWorkflow(workflow.ID, &n8n.UpdateWorkflowRequest{
+			Name:        r.workflowNames.apply(plan.Name.ValueString()),
+			Nodes:       workflow.Nodes,
+			Connections: workflow.Connections,
+			Settings:    workflow.Settings,
+			Extra:       workflow.WritableExtra(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating workflow", err.Error())
+			return
+		}
+	}
+
+	if plan.Active.ValueBool() != workflow.Active {
+		if plan.Active.ValueBool() {
+			workflow, err = r.client.ActivateWorkflow(workflow.ID)
+		} else {
+			workflow, err = r.client.DeactivateWorkflow(workflow.ID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error changing workflow activation state", err.Error())
+			return
+		}
+	}
+
+	workflow = readWorkflowAfterWrite(ctx, r.client, workflow)
+
+	plan.ID = state.ID
+	plan.Active = types.BoolValue(workflow.Active)
+	plan.VersionId = types.StringValue(workflow.VersionId)
+	plan.CreatedAt = types.StringValue(workflow.CreatedAt)
+	plan.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowCloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting cloned workflow", map[string]any{"id": state.ID.ValueString()})
+
+	if _, err := r.client.DeleteWorkflow(state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting workflow", err.Error())
+		return
+	}
+}
+
+// workflowCloneToState stores the computed attributes of a copied workflow in
+// a resource model.
+func workflowCloneToState(workflow *n8n.Workflow, model *workflowCloneResourceModel) {
+	model.ID = types.StringValue(workflow.ID)
+	model.Active = types.BoolValue(workflow.Active)
+	model.VersionId = types.StringValue(workflow.VersionId)
+	model.CreatedAt = types.StringValue(workflow.CreatedAt)
+	model.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+}
+
+// substituteNodeParameters returns a copy of nodes where every occurrence of
+// the substitution keys in string node parameters is replaced by its value.
+// Longer keys are replaced first so that overlapping keys behave predictably.
+func substituteNodeParameters(nodes []n8n.Node, substitutions map[string]string) []n8n.Node {
+	if len(substitutions) == 0 {
+		return nodes
+	}
+
+	keys := make([]string, 0, len(substitutions))
+	for key := range substitutions {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	pairs := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key, substitutions[key])
+	}
+	replacer := strings.NewReplacer(pairs...)
+
+	result := make([]n8n.Node, len(nodes))
+	for i, node := range nodes {
+		result[i] = node
+		if node.Parameters != nil {
+			result[i].Parameters = substituteValue(node.Parameters, replacer).(map[string]interface{})
+		}
+	}
+	return result
+}
+
+// substituteValue applies replacer to every string in a decoded JSON value.
+func substituteValue(value interface{}, replacer *strings.Replacer) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			result[key] = substituteValue(child, replacer)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, child := range v {
+			result[i] = substituteValue(child, replacer)
+		}
+		return result
+	case string:
+		return replacer.Replace(v)
+	default:
+		return v
+	}
+}
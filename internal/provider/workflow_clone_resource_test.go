@@ -0,0 +1,181 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorkflowCloneResourceState returns an empty state using the
+// n8n_workflow_clone schema.
+func newWorkflowCloneResourceState(ctx context.Context, t *testing.T) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	NewWorkflowCloneResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// newWorkflowCloneResourcePlan returns a plan of the n8n_workflow_clone
+// schema holding the given model.
+func newWorkflowCloneResourcePlan(ctx context.Context, t *testing.T, model workflowCloneResourceModel) tfsdk.Plan {
+	t.Helper()
+
+	state := newWorkflowCloneResourceState(ctx, t)
+	require.False(t, state.Set(ctx, &model).HasError())
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// testWorkflowCloneResourceModel returns a planned copy of workflow src.
+func testWorkflowCloneResourceModel() workflowCloneResourceModel {
+	return workflowCloneResourceModel{
+		ID:               types.StringUnknown(),
+		SourceWorkflowID: types.StringValue("src"),
+		Name:             types.StringValue("Orders copy"),
+		Active:           types.BoolValue(false),
+		Substitutions:    types.MapNull(types.StringType),
+		VersionId:        types.StringUnknown(),
+		CreatedAt:        types.StringUnknown(),
+		UpdatedAt:        types.StringUnknown(),
+	}
+}
+
+func TestWorkflowCloneCreateKeepsContent(t *testing.T) {
+	ctx := context.Background()
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows/src", http.StatusOK, `{"id": "src", "name": "Orders", "nodes": `+editorNodes+`, "connections": `+editorConnections+`, "settings": {"timezone": "UTC"}, "staticData": {"lastId": 42}, "pinData": {"Agent": [{"json": {}}]}, "shared": [], "isArchived": false, "meta": {"templateId": "42"}}`)
+	server.Respond("POST /api/v1/workflows", http.StatusOK, `{"id": "wf1", "name": "Orders copy"}`)
+	server.Respond("GET /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders copy"}`)
+	r := &workflowCloneResource{client: server.Client()}
+
+	req := resource.CreateRequest{Plan: newWorkflowCloneResourcePlan(ctx, t, testWorkflowCloneResourceModel())}
+	resp := resource.CreateResponse{State: newWorkflowCloneResourceState(ctx, t)}
+	r.Create(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	requests := server.Requests("POST /api/v1/workflows")
+	require.Len(t, requests, 1)
+	assertEditorContentKept(t, requests[0])
+
+	// Only the fields the create endpoint accepts are copied
+	var sent map[string]any
+	requests[0].DecodeBody(t, &sent)
+	keys := make([]string, 0, len(sent))
+	for key := range sent {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"name", "nodes", "connections", "settings", "staticData", "pinData"}, keys)
+}
+
+func TestSubstituteNodeParameters(t *testing.T) {
+	nodes := []n8n.Node{
+		{
+			Name: "__CUSTOMER__ webhook",
+			Parameters: map[string]interface{}{
+				"path":    "__CUSTOMER__/orders",
+				"url":     "https://__CUSTOMER_HOST__/api",
+				"retries": 3,
+				"headers": []interface{}{
+					map[string]interface{}{"name": "X-Customer", "value": "__CUSTOMER__"},
+				},
+			},
+		},
+		{Name: "No parameters"},
+	}
+
+	result := substituteNodeParameters(nodes, map[string]string{
+		"__CUSTOMER__":      "acme",
+		"__CUSTOMER_HOST__": "acme.example.com",
+	})
+
+	assert.Equal(t, "__CUSTOMER__ webhook", result[0].Name, "only parameters are substituted")
+	assert.Equal(t, "acme/orders", result[0].Parameters["path"])
+	assert.Equal(t, "https://acme.example.com/api", result[0].Parameters["url"])
+	assert.Equal(t, 3, result[0].Parameters["retries"])
+	assert.Equal(t, "acme", result[0].Parameters["headers"].([]interface{})[0].(map[string]interface{})["value"])
+	assert.Nil(t, result[1].Parameters)
+
+	assert.Equal(t, "__CUSTOMER__/orders", nodes[0].Parameters["path"], "source nodes must not be modified")
+}
+
+func TestWorkflowCloneRenameKeepsContent(t *testing.T) {
+	ctx := context.Background()
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders copy", "versionId": "v1", "nodes": `+editorNodes+`, "connections": `+editorConnections+`, "settings": {"timezone": "UTC"}, "staticData": {"lastId": 42}, "shared": [], "isArchived": false, "meta": {"templateId": "42"}}`)
+	server.Respond("PUT /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders renamed", "versionId": "v1"}`)
+	r := &workflowCloneResource{client: server.Client()}
+
+	state := testWorkflowCloneResourceModel()
+	state.ID = types.StringValue("wf1")
+	state.VersionId = types.StringValue("v1")
+	state.CreatedAt = types.StringValue("")
+	state.UpdatedAt = types.StringValue("")
+	plan := state
+	plan.Name = types.StringValue("Orders renamed")
+
+	priorState := newWorkflowCloneResourceState(ctx, t)
+	require.False(t, priorState.Set(ctx, &state).HasError())
+	req := resource.UpdateRequest{
+		Plan:  newWorkflowCloneResourcePlan(ctx, t, plan),
+		State: priorState,
+	}
+	resp := resource.UpdateResponse{State: newWorkflowCloneResourceState(ctx, t)}
+	r.Update(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	requests := server.Requests("PUT /api/v1/workflows/wf1")
+	require.Len(t, requests, 1)
+	assertEditorContentKept(t, requests[0])
+
+	// Only the fields the update endpoint accepts are sent back
+	var sent map[string]any
+	requests[0].DecodeBody(t, &sent)
+	keys := make([]string, 0, len(sent))
+	for key := range sent {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"name", "nodes", "connections", "settings", "staticData"}, keys)
+	assert.Equal(t, "Orders renamed", sent["name"])
+}
+
+func TestWorkflowCloneCreateSavesStateBeforeActivating(t *testing.T) {
+	ctx := context.Background()
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows/src", http.StatusOK, `{"id": "src", "name": "Orders", "nodes": [], "connections": {}}`)
+	server.Respond("POST /api/v1/workflows", http.StatusOK, `{"id": "wf1", "name": "Orders copy", "versionId": "v1"}`)
+	server.Respond("POST /api/v1/workflows/wf1/activate", http.StatusBadRequest, `{"message": "Workflow has no trigger node"}`)
+	server.Respond("GET /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders copy", "versionId": "v1"}`)
+	r := &workflowCloneResource{client: server.Client()}
+
+	plan := testWorkflowCloneResourceModel()
+	plan.Active = types.BoolValue(true)
+	req := resource.CreateRequest{Plan: newWorkflowCloneResourcePlan(ctx, t, plan)}
+	resp := resource.CreateResponse{State: newWorkflowCloneResourceState(ctx, t)}
+	r.Create(ctx, req, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "saved in state")
+
+	// The copy is tracked, inactive, so it is not created again
+	var saved workflowCloneResourceModel
+	require.False(t, resp.State.Get(ctx, &saved).HasError())
+	assert.Equal(t, "wf1", saved.ID.ValueString())
+	assert.False(t, saved.Active.ValueBool())
+	assert.Equal(t, "v1", saved.VersionId.ValueString())
+}
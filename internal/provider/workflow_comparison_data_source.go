@@ -0,0 +1,269 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowComparisonDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowComparisonDataSource{}
+)
+
+// NewWorkflowComparisonDataSource returns a new data source.
+func NewWorkflowComparisonDataSource() datasource.DataSource {
+	return &workflowComparisonDataSource{}
+}
+
+type workflowComparisonDataSource struct {
+	client *n8n.Client
+}
+
+type workflowComparisonDataSourceModel struct {
+	WorkflowID            types.String              `tfsdk:"workflow_id"`
+	ExportJSON            types.String              `tfsdk:"export_json"`
+	IgnoreEmptyParameters types.Bool                `tfsdk:"ignore_empty_parameters"`
+	IgnorePaths           *ignorePathsResourceModel `tfsdk:"ignore_paths"`
+	InSync                types.Bool                `tfsdk:"in_sync"`
+	Differences           []workflowDifferenceModel `tfsdk:"differences"`
+}
+
+type workflowDifferenceModel struct {
+	Path     types.String `tfsdk:"path"`
+	Expected types.String `tfsdk:"expected"`
+	Actual   types.String `tfsdk:"actual"`
+}
+
+// workflowExport holds the parts of an exported workflow that are compared.
+type workflowExport struct {
+	Nodes       json.RawMessage        `json:"nodes"`
+	Connections json.RawMessage        `json:"connections"`
+	Settings    map[string]interface{} `json:"settings"`
+}
+
+func (d *workflowComparisonDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *workflowComparisonDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_comparison"
+}
+
+func (d *workflowComparisonDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Compares a workflow on the configured instance with an exported workflow, using the same normalization as the n8n_workflow resource. Useful as a promotion gate between environments. To compare workflows on two instances, compare the content_hash of n8n_workflow data sources using different provider aliases instead.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the workflow on the configured instance.",
+			},
+			"export_json": schema.StringAttribute{
+				Required:    true,
+				Description: "Exported workflow JSON, as downloaded from the n8n editor or returned by the API. Its `nodes` and `connections` are compared, and its `settings` when present.",
+			},
+			"ignore_empty_parameters": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Treat empty values inside node parameters as equivalent to missing keys, see the n8n_workflow resource.",
+			},
+			"ignore_paths": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "JSON pointers of values excluded from the comparison, see the n8n_workflow resource.",
+				Attributes: map[string]schema.Attribute{
+					"nodes": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "JSON pointers relative to the `nodes` array.",
+						Validators: []validator.List{
+							JSONPointers(),
+						},
+					},
+					"connections": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "JSON pointers relative to the `connections` object.",
+						Validators: []validator.List{
+							JSONPointers(),
+						},
+					},
+				},
+			},
+			"in_sync": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the workflow matches the export.",
+			},
+			"differences": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Differences between the export and the workflow.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON pointer of the differing value, starting with `/nodes`, `/connections` or `/settings`.",
+						},
+						"expected": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON value in the export, empty when missing.",
+						},
+						"actual": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON value in the workflow, empty when missing.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workflowComparisonDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workflowComparisonDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var export workflowExport
+	if err := json.Unmarshal([]byte(state.ExportJSON.ValueString()), &export); err != nil {
+		resp.Diagnostics.AddError("Invalid export JSON", err.Error())
+		return
+	}
+
+	workflow, err := d.client.GetWorkflow(state.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving workflow", err.Error())
+		return
+	}
+
+	nodesOpts := jsonSemanticOptions{ignoreEmptyParameters: state.IgnoreEmptyParameters.ValueBool()}
+	connectionsOpts := jsonSemanticOptions{}
+	if state.IgnorePaths != nil {
+		var nodePointers, connectionPointers []string
+		resp.Diagnostics.Append(state.IgnorePaths.Nodes.ElementsAs(ctx, &nodePointers, false)...)
+		resp.Diagnostics.Append(state.IgnorePaths.Connections.ElementsAs(ctx, &connectionPointers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Invalid pointers are reported by the attribute validator
+		nodesOpts.ignorePaths, _ = parseJSONPointers(nodePointers)
+		connectionsOpts.ignorePaths, _ = parseJSONPointers(connectionPointers)
+	}
+
+	differences, err := compareWorkflowExport(export, workflow, nodesOpts, connectionsOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error comparing workflow", err.Error())
+		return
+	}
+
+	state.InSync = types.BoolValue(len(differences) == 0)
+	state.Differences = make([]workflowDifferenceModel, 0, len(differences))
+	for _, difference := range differences {
+		state.Differences = append(state.Differences, workflowDifferenceModel{
+			Path:     types.StringValue(difference.Path),
+			Expected: types.StringValue(difference.Expected),
+			Actual:   types.StringValue(difference.Actual),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// workflowExportSection is a part of a workflow compared by compareWorkflowExport.
+type workflowExportSection struct {
+	name     string
+	expected []byte
+	actual   []byte
+	opts     jsonSemanticOptions
+}
+
+// compareWorkflowExport returns the differences between an exported workflow
+// and a workflow read from n8n. Settings are only compared when the export
+// contains them, and only for the keys it sets.
+func compareWorkflowExport(export workflowExport, workflow *n8n.Workflow, nodesOpts, connectionsOpts jsonSemanticOptions) ([]jsonDifference, error) {
+	var differences []jsonDifference
+
+	nodesJSON, err := json.Marshal(workflow.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	connectionsJSON, err := json.Marshal(workflow.Connections)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := []workflowExportSection{
+		{name: "nodes", expected: export.Nodes, actual: nodesJSON, opts: nodesOpts},
+		{name: "connections", expected: export.Connections, actual: connectionsJSON, opts: connectionsOpts},
+	}
+
+	if export.Settings != nil {
+		expectedSettings, err := json.Marshal(export.Settings)
+		if err != nil {
+			return nil, err
+		}
+
+		var allSettings map[string]interface{}
+		if err := remarshalJSON(workflow.Settings, &allSettings); err != nil {
+			return nil, err
+		}
+		actualSettings := make(map[string]interface{}, len(export.Settings))
+		for key := range export.Settings {
+			if value, ok := allSettings[key]; ok {
+				actualSettings[key] = value
+			}
+		}
+		actualSettingsJSON, err := json.Marshal(actualSettings)
+		if err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, workflowExportSection{name: "settings", expected: expectedSettings, actual: actualSettingsJSON})
+	}
+
+	for _, section := range sections {
+		expected := string(section.expected)
+		if len(section.expected) == 0 {
+			expected = "null"
+		}
+
+		sectionDifferences, err := diffJSON(expected, string(section.actual), section.opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", section.name, err)
+		}
+		for _, difference := range sectionDifferences {
+			difference.Path = "/" + section.name + difference.Path
+			differences = append(differences, difference)
+		}
+	}
+
+	return differences, nil
+}
+
+// remarshalJSON converts a value to another type through its JSON encoding.
+func remarshalJSON(in interface{}, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
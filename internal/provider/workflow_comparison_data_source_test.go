@@ -0,0 +1,48 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareWorkflowExport(t *testing.T) {
+	workflow := testContentHashWorkflow()
+
+	t.Run("in sync", func(t *testing.T) {
+		var export workflowExport
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"name": "Exported under another name",
+			"nodes": [{"id": "1", "name": "Start", "type": "n8n-nodes-base.manualTrigger", "typeVersion": 0, "position": [], "parameters": {"b": "x", "a": 1}}],
+			"connections": {"Start": {"main": [[{"node": "End", "type": "main", "index": 0}]]}},
+			"settings": {"timezone": "America/New_York"}
+		}`), &export))
+
+		differences, err := compareWorkflowExport(export, workflow, jsonSemanticOptions{}, jsonSemanticOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, differences)
+	})
+
+	t.Run("out of sync", func(t *testing.T) {
+		var export workflowExport
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"nodes": [{"id": "1", "name": "Start", "type": "n8n-nodes-base.manualTrigger", "typeVersion": 0, "parameters": {"b": "x", "a": 2}}],
+			"connections": {},
+			"settings": {"timezone": "UTC"}
+		}`), &export))
+
+		differences, err := compareWorkflowExport(export, workflow, jsonSemanticOptions{}, jsonSemanticOptions{})
+		require.NoError(t, err)
+
+		var paths []string
+		for _, difference := range differences {
+			paths = append(paths, difference.Path)
+		}
+		assert.Equal(t, []string{"/nodes/0/parameters/a", "/connections", "/settings/timezone"}, paths)
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowCredentialsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowCredentialsDataSource{}
+)
+
+// NewWorkflowCredentialsDataSource returns a new data source.
+func NewWorkflowCredentialsDataSource() datasource.DataSource {
+	return &workflowCredentialsDataSource{}
+}
+
+type workflowCredentialsDataSource struct {
+	client *n8n.Client
+}
+
+type workflowCredentialsDataSourceModel struct {
+	Tags      types.List                          `tfsdk:"tags"`
+	Workflows map[string]workflowCredentialsModel `tfsdk:"workflows"`
+}
+
+type workflowCredentialsModel struct {
+	Name        types.String               `tfsdk:"name"`
+	Credentials []credentialReferenceModel `tfsdk:"credentials"`
+}
+
+type credentialReferenceModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Node types.String `tfsdk:"node"`
+}
+
+func (d *workflowCredentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *workflowCredentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_credentials"
+}
+
+func (d *workflowCredentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the credentials referenced by the nodes of each workflow, for audits of credential usage.",
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return workflows that have all of these tags.",
+			},
+			"workflows": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Credential references keyed by workflow ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the workflow.",
+						},
+						"credentials": schema.ListNestedAttribute{
+							Computed:     true,
+							Description:  "Credentials referenced by the workflow nodes, ordered by node name then credential type.",
+							NestedObject: credentialReferenceNestedObject(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workflowCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workflowCredentialsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagFilter []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &tagFilter, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflows, err := workflowsWithAllTags(d.client, tagFilter)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	state.Workflows = make(map[string]workflowCredentialsModel, len(workflows))
+	for _, workflow := range workflows {
+		credentials := []credentialReferenceModel{}
+		for _, reference := range nodeCredentialReferences(workflow.Nodes) {
+			credentials = append(credentials, credentialReferenceModel{
+				Type: types.StringValue(reference.Type),
+				ID:   types.StringValue(reference.ID),
+				Name: types.StringValue(reference.Name),
+				Node: types.StringValue(reference.Node),
+			})
+		}
+
+		state.Workflows[workflow.ID] = workflowCredentialsModel{
+			Name:        types.StringValue(workflow.Name),
+			Credentials: credentials,
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
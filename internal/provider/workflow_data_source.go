@@ -34,10 +34,23 @@ type workflowDataSourceModel struct {
 	TriggerCount types.Int64    `tfsdk:"trigger_count"`
 	CreatedAt    types.String   `tfsdk:"created_at"`
 	UpdatedAt    types.String   `tfsdk:"updated_at"`
+	ContentHash  types.String   `tfsdk:"content_hash"`
 	Nodes        []nodesModel   `tfsdk:"nodes"`
+	NodesJSON    types.String   `tfsdk:"nodes_json"`
 	Connections  types.String   `tfsdk:"connections"`
 	Settings     *settingsModel `tfsdk:"settings"`
+	SettingsJSON types.String   `tfsdk:"settings_json"`
 	Tags         []tagsModel    `tfsdk:"tags"`
+
+	LastExecution *executionModel `tfsdk:"last_execution"`
+}
+
+type executionModel struct {
+	ID        types.String `tfsdk:"id"`
+	Status    types.String `tfsdk:"status"`
+	Mode      types.String `tfsdk:"mode"`
+	StartedAt types.String `tfsdk:"started_at"`
+	StoppedAt types.String `tfsdk:"stopped_at"`
 }
 
 func (d *workflowDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -78,7 +91,7 @@ func (d *workflowDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"trigger_count": schema.Int64Attribute{
 				Computed:    true,
-				Description: "Number of times the workflow has been triggered.",
+				Description: "Number of triggers n8n registered for the workflow. Zero while the workflow is inactive.",
 			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
@@ -88,13 +101,51 @@ func (d *workflowDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 				Description: "Timestamp when the workflow was last updated.",
 			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: contentHashDescription,
+			},
 			"nodes": workflowsNodeAttr(),
+			"nodes_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded array of workflow nodes, in the canonical form used by the n8n_workflow resource. Can be passed to the `nodes` attribute of an n8n_workflow to clone the workflow.",
+			},
 			"connections": schema.StringAttribute{
 				Computed:    true,
-				Description: "JSON-encoded connections data.",
+				Description: "JSON-encoded connections data, in the canonical form used by the n8n_workflow resource.",
 			},
 			"settings": workflowsSettingsAttr(),
-			"tags":     workflowsTagsAttr(),
+			"settings_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded workflow settings as returned by the n8n API, in canonical form.",
+			},
+			"tags": workflowsTagsAttr(),
+			"last_execution": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Most recent execution of the workflow, null if it never ran or its executions were pruned.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Execution ID.",
+					},
+					"status": schema.StringAttribute{
+						Computed:    true,
+						Description: "Outcome of the execution, e.g. `success`, `error`, `running` or `waiting`.",
+					},
+					"mode": schema.StringAttribute{
+						Computed:    true,
+						Description: "How the execution was started, e.g. `trigger`, `webhook` or `manual`.",
+					},
+					"started_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "Timestamp when the execution started.",
+					},
+					"stopped_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "Timestamp when the execution stopped, empty while it is running.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -149,12 +200,30 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 		})
 	}
 
-	connectionsJSON, err := ConvertConnectionsToTerraformMap(workflow.Connections)
+	nodesJSON, err := workflowCanonicalJSON(workflow.Nodes)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to marshal nodes", err.Error())
+		return
+	}
+
+	connectionsJSON, err := workflowCanonicalJSON(workflow.Connections)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to marshal connections", err.Error())
 		return
 	}
 
+	settingsJSON, err := workflowCanonicalJSON(workflow.Settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to marshal settings", err.Error())
+		return
+	}
+
+	contentHash, err := workflowContentHash(workflow)
+	if err != nil {
+		resp.Diagnostics.AddError("Error hashing workflow content", err.Error())
+		return
+	}
+
 	state.ID = types.StringValue(workflow.ID)
 	state.Name = types.StringValue(workflow.Name)
 	state.Active = types.BoolValue(workflow.Active)
@@ -162,21 +231,39 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 	state.TriggerCount = types.Int64Value(int64(workflow.TriggerCount))
 	state.CreatedAt = types.StringValue(workflow.CreatedAt)
 	state.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+	state.ContentHash = types.StringValue(contentHash)
 	state.Nodes = nodes
-	state.Connections = connectionsJSON
+	state.NodesJSON = types.StringValue(nodesJSON)
+	state.Connections = types.StringValue(connectionsJSON)
+	state.SettingsJSON = types.StringValue(settingsJSON)
 	state.Settings = &settingsModel{
-		SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-		SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-		SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-		SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-		ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-		Timezone:                 types.StringValue(workflow.Settings.Timezone),
-		ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
+		SaveExecutionProgress:    types.BoolValue(workflow.Settings.GetSaveExecutionProgress()),
+		SaveManualExecutions:     types.BoolValue(workflow.Settings.GetSaveManualExecutions()),
+		SaveDataErrorExecution:   types.StringValue(workflow.Settings.GetSaveDataErrorExecution()),
+		SaveDataSuccessExecution: types.StringValue(workflow.Settings.GetSaveDataSuccessExecution()),
+		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.GetExecutionTimeout())),
+		ErrorWorkflow:            types.StringValue(workflow.Settings.GetErrorWorkflow()),
+		Timezone:                 types.StringValue(workflow.Settings.GetTimezone()),
+		ExecutionOrder:           types.StringValue(workflow.Settings.GetExecutionOrder()),
 	}
 
 	state.Tags = tags
 
+	lastExecution, err := d.client.GetLastExecution(workflow.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving last workflow execution", err.Error())
+		return
+	}
+	if lastExecution != nil {
+		state.LastExecution = &executionModel{
+			ID:        types.StringValue(lastExecution.ID.String()),
+			Status:    types.StringValue(lastExecution.Status),
+			Mode:      types.StringValue(lastExecution.Mode),
+			StartedAt: types.StringValue(lastExecution.StartedAt),
+			StoppedAt: types.StringValue(lastExecution.StoppedAt),
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
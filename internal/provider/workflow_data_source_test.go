@@ -40,14 +40,14 @@ func TestWorkflowDataSource(t *testing.T) {
 		},
 		Connections: map[string]n8n.Connection{},
 		Settings: n8n.Settings{
-			SaveExecutionProgress:    true,
-			SaveManualExecutions:     true,
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
-			ExecutionTimeout:         3600,
-			ErrorWorkflow:            "",
-			Timezone:                 "America/New_York",
-			ExecutionOrder:           "v1",
+			SaveExecutionProgress:    n8n.Ptr(true),
+			SaveManualExecutions:     n8n.Ptr(true),
+			SaveDataErrorExecution:   n8n.Ptr("all"),
+			SaveDataSuccessExecution: n8n.Ptr("all"),
+			ExecutionTimeout:         n8n.Ptr(3600),
+			ErrorWorkflow:            n8n.Ptr(""),
+			Timezone:                 n8n.Ptr("America/New_York"),
+			ExecutionOrder:           n8n.Ptr("v1"),
 		},
 	}
 
@@ -81,17 +81,19 @@ func TestWorkflowDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.n8n_workflow.test", "version_id", createdWorkflow.VersionId),
 
 					// Settings
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_execution_progress", fmt.Sprintf("%t", createdWorkflow.Settings.SaveExecutionProgress)),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_manual_executions", fmt.Sprintf("%t", createdWorkflow.Settings.SaveManualExecutions)),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_data_error_execution", createdWorkflow.Settings.SaveDataErrorExecution),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_data_success_execution", createdWorkflow.Settings.SaveDataSuccessExecution),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.execution_timeout", fmt.Sprintf("%d", createdWorkflow.Settings.ExecutionTimeout)),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.error_workflow", createdWorkflow.Settings.ErrorWorkflow),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.timezone", createdWorkflow.Settings.Timezone),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.execution_order", createdWorkflow.Settings.ExecutionOrder),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_execution_progress", fmt.Sprintf("%t", createdWorkflow.Settings.GetSaveExecutionProgress())),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_manual_executions", fmt.Sprintf("%t", createdWorkflow.Settings.GetSaveManualExecutions())),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_data_error_execution", createdWorkflow.Settings.GetSaveDataErrorExecution()),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.save_data_success_execution", createdWorkflow.Settings.GetSaveDataSuccessExecution()),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.execution_timeout", fmt.Sprintf("%d", createdWorkflow.Settings.GetExecutionTimeout())),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.error_workflow", createdWorkflow.Settings.GetErrorWorkflow()),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.timezone", createdWorkflow.Settings.GetTimezone()),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.execution_order", createdWorkflow.Settings.GetExecutionOrder()),
 
 					resource.TestCheckResourceAttr("data.n8n_workflow.test", "trigger_count", fmt.Sprintf("%d", createdWorkflow.TriggerCount)),
 					resource.TestCheckResourceAttr("data.n8n_workflow.test", "connections", "{}"),
+					resource.TestCheckResourceAttrSet("data.n8n_workflow.test", "nodes_json"),
+					resource.TestCheckResourceAttrSet("data.n8n_workflow.test", "settings_json"),
 
 					resource.TestCheckNoResourceAttr("data.n8n_workflow.test", "tags"),
 					resource.TestCheckResourceAttrSet("data.n8n_workflow.test", "nodes.#"),
@@ -0,0 +1,102 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// subWorkflowNodeTypes are the node types calling another workflow of the
+// instance through their workflowId parameter.
+var subWorkflowNodeTypes = map[string]bool{
+	"n8n-nodes-base.executeWorkflow":        true,
+	"@n8n/n8n-nodes-langchain.toolWorkflow": true,
+}
+
+// calledWorkflowIDs returns the sorted IDs of the workflows called by the
+// given nodes. Disabled nodes, workflows loaded from a file, URL or parameter,
+// and IDs set by an expression are skipped since they cannot be resolved
+// statically.
+func calledWorkflowIDs(nodes []n8n.Node) []string {
+	seen := make(map[string]bool)
+	ids := []string{}
+	for _, node := range nodes {
+		if !subWorkflowNodeTypes[node.Type] || node.Disabled {
+			continue
+		}
+		if source, ok := node.Parameters["source"].(string); ok && source != "database" {
+			continue
+		}
+
+		id := workflowIDParameter(node.Parameters["workflowId"])
+		if id == "" || strings.HasPrefix(id, "=") || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// workflowIDParameter returns the workflow ID of a workflowId node parameter,
+// which newer node versions store as a resource locator object.
+func workflowIDParameter(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case map[string]interface{}:
+		if id, ok := value["value"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// workflowDependencies returns the workflows called by each of the given
+// workflows, keyed by caller ID, and the sorted IDs of the called workflows
+// missing from the given ones.
+func workflowDependencies(workflows []n8n.Workflow) (map[string][]string, []string) {
+	known := make(map[string]bool, len(workflows))
+	for _, workflow := range workflows {
+		known[workflow.ID] = true
+	}
+
+	dependencies := make(map[string][]string, len(workflows))
+	missingSet := make(map[string]bool)
+	for _, workflow := range workflows {
+		called := calledWorkflowIDs(workflow.Nodes)
+		dependencies[workflow.ID] = called
+		for _, id := range called {
+			if !known[id] {
+				missingSet[id] = true
+			}
+		}
+	}
+
+	missing := make([]string, 0, len(missingSet))
+	for id := range missingSet {
+		missing = append(missing, id)
+	}
+	sort.Strings(missing)
+	return dependencies, missing
+}
+
+// reverseDependencies returns the callers of each called workflow, keyed by
+// called workflow ID.
+func reverseDependencies(dependencies map[string][]string) map[string][]string {
+	dependents := make(map[string][]string)
+	for caller, called := range dependencies {
+		for _, id := range called {
+			dependents[id] = append(dependents[id], caller)
+		}
+	}
+	for id := range dependents {
+		sort.Strings(dependents[id])
+	}
+	return dependents
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowDependenciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowDependenciesDataSource{}
+)
+
+// NewWorkflowDependenciesDataSource returns a new data source.
+func NewWorkflowDependenciesDataSource() datasource.DataSource {
+	return &workflowDependenciesDataSource{}
+}
+
+type workflowDependenciesDataSource struct {
+	client *n8n.Client
+}
+
+type workflowDependenciesDataSourceModel struct {
+	Dependencies map[string][]string `tfsdk:"dependencies"`
+	Dependents   map[string][]string `tfsdk:"dependents"`
+	MissingIDs   []string            `tfsdk:"missing_ids"`
+}
+
+func (d *workflowDependenciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *workflowDependenciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_dependencies"
+}
+
+func (d *workflowDependenciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the graph of sub-workflow calls made by the Execute Workflow and Call Workflow Tool nodes of the instance, for impact analysis and ordering checks. Calls whose target is set by an expression or loaded from a file, URL or parameter are not included.",
+		Attributes: map[string]schema.Attribute{
+			"dependencies": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Sorted IDs of the workflows called by each workflow, keyed by caller ID. Every workflow of the instance has an entry.",
+			},
+			"dependents": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Sorted IDs of the workflows calling each workflow, keyed by called workflow ID. Workflows nobody calls have no entry.",
+			},
+			"missing_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted IDs of the called workflows that do not exist on the instance.",
+			},
+		},
+	}
+}
+
+func (d *workflowDependenciesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	workflows, err := d.client.GetWorkflows()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	var state workflowDependenciesDataSourceModel
+	state.Dependencies, state.MissingIDs = workflowDependencies(workflows.Data)
+	state.Dependents = reverseDependencies(state.Dependencies)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
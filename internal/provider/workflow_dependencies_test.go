@@ -0,0 +1,45 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalledWorkflowIDs(t *testing.T) {
+	nodes := []n8n.Node{
+		{Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "wf3"}},
+		{Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{
+			"source":     "database",
+			"workflowId": map[string]interface{}{"__rl": true, "value": "wf2", "mode": "list"},
+		}},
+		{Type: "@n8n/n8n-nodes-langchain.toolWorkflow", Parameters: map[string]interface{}{"workflowId": "wf3"}},
+		{Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "={{ $json.target }}"}},
+		{Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"source": "localFile", "workflowPath": "/tmp/wf.json"}},
+		{Type: "n8n-nodes-base.executeWorkflow", Disabled: true, Parameters: map[string]interface{}{"workflowId": "wf9"}},
+		{Type: "n8n-nodes-base.set", Parameters: map[string]interface{}{"workflowId": "wf8"}},
+	}
+
+	assert.Equal(t, []string{"wf2", "wf3"}, calledWorkflowIDs(nodes))
+}
+
+func TestWorkflowDependencies(t *testing.T) {
+	call := func(id string) n8n.Node {
+		return n8n.Node{Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": id}}
+	}
+	workflows := []n8n.Workflow{
+		{ID: "wf1", Nodes: []n8n.Node{call("wf3"), call("wf2")}},
+		{ID: "wf2", Nodes: []n8n.Node{call("wf3"), call("gone")}},
+		{ID: "wf3"},
+	}
+
+	dependencies, missing := workflowDependencies(workflows)
+	assert.Equal(t, map[string][]string{"wf1": {"wf2", "wf3"}, "wf2": {"gone", "wf3"}, "wf3": {}}, dependencies)
+	assert.Equal(t, []string{"gone"}, missing)
+
+	assert.Equal(t, map[string][]string{"wf2": {"wf1"}, "wf3": {"wf1", "wf2"}, "gone": {"wf2"}}, reverseDependencies(dependencies))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowLimitsModel maps the workflow_limits provider attribute.
+type workflowLimitsModel struct {
+	MaxNodes           types.Int64 `tfsdk:"max_nodes"`
+	MaxSize            types.Int64 `tfsdk:"max_size"`
+	MaxExpressionDepth types.Int64 `tfsdk:"max_expression_depth"`
+	Enforce            types.Bool  `tfsdk:"enforce"`
+}
+
+// workflowLimits are the size and complexity limits of managed workflows. Zero
+// values are not checked.
+type workflowLimits struct {
+	maxNodes           int
+	maxSize            int
+	maxExpressionDepth int
+
+	// enforce fails plans exceeding a limit instead of warning.
+	enforce bool
+}
+
+// workflowLimitsFromModel returns the limits configured in the provider, or
+// no limits if workflow_limits is not set.
+func workflowLimitsFromModel(model *workflowLimitsModel) workflowLimits {
+	if model == nil {
+		return workflowLimits{}
+	}
+	return workflowLimits{
+		maxNodes:           int(model.MaxNodes.ValueInt64()),
+		maxSize:            int(model.MaxSize.ValueInt64()),
+		maxExpressionDepth: int(model.MaxExpressionDepth.ValueInt64()),
+		enforce:            model.Enforce.ValueBool(),
+	}
+}
+
+// enabled reports whether any limit is set.
+func (l workflowLimits) enabled() bool {
+	return l.maxNodes > 0 || l.maxSize > 0 || l.maxExpressionDepth > 0
+}
+
+// exceeded returns a description of each limit exceeded by a workflow with the
+// given nodes and JSON-encoded nodes and connections. The size is that of the
+// compacted JSON, as sent to n8n.
+func (l workflowLimits) exceeded(nodes []n8n.Node, nodesJSON, connectionsJSON string) []string {
+	var exceeded []string
+
+	if count := countedNodes(nodes); l.maxNodes > 0 && count > l.maxNodes {
+		exceeded = append(exceeded, fmt.Sprintf("The workflow has %d nodes, more than the limit of %d.", count, l.maxNodes))
+	}
+
+	if l.maxSize > 0 {
+		size := compactedSize(nodesJSON) + compactedSize(connectionsJSON)
+		if size > l.maxSize {
+			exceeded = append(exceeded, fmt.Sprintf("The nodes and connections of the workflow weigh %d bytes, more than the limit of %d.", size, l.maxSize))
+		}
+	}
+
+	if l.maxExpressionDepth > 0 {
+		for _, node := range nodes {
+			if depth := parameterExpressionDepth(node.Parameters); depth > l.maxExpressionDepth {
+				exceeded = append(exceeded, fmt.Sprintf("Node %q has an expression nested %d levels deep, more than the limit of %d.", node.Name, depth, l.maxExpressionDepth))
+			}
+		}
+	}
+
+	return exceeded
+}
+
+// countedNodes returns the number of nodes, leaving out sticky notes which
+// are never executed.
+func countedNodes(nodes []n8n.Node) int {
+	count := 0
+	for _, node := range nodes {
+		if node.Type != stickyNoteNodeType {
+			count++
+		}
+	}
+	return count
+}
+
+// compactedSize returns the size of JSON without insignificant whitespace, or
+// its size as is if it is invalid.
+func compactedSize(data string) int {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(data)); err != nil {
+		return len(data)
+	}
+	return compacted.Len()
+}
+
+// parameterExpressionDepth returns the deepest nesting of parentheses,
+// brackets and braces within the expressions of decoded parameter values.
+func parameterExpressionDepth(value interface{}) int {
+	depth := 0
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, child := range value {
+			depth = max(depth, parameterExpressionDepth(child))
+		}
+	case []interface{}:
+		for _, child := range value {
+			depth = max(depth, parameterExpressionDepth(child))
+		}
+	case string:
+		if strings.HasPrefix(value, "=") {
+			depth = expressionDepth(value[1:])
+		}
+	}
+	return depth
+}
+
+// expressionDepth returns the deepest nesting of parentheses, brackets and
+// braces within the {{ }} blocks of an expression, the blocks themselves
+// being the first level.
+func expressionDepth(expression string) int {
+	deepest := 0
+	for {
+		start := strings.Index(expression, "{{")
+		if start < 0 {
+			return deepest
+		}
+		expression = expression[start+2:]
+
+		depth, level := 1, 1
+		end := len(expression)
+		for i := 0; i < len(expression); i++ {
+			if strings.HasPrefix(expression[i:], "}}") && level == 1 {
+				end = i + 2
+				break
+			}
+			switch expression[i] {
+			case '(', '[', '{':
+				level++
+				depth = max(depth, level)
+			case ')', ']', '}':
+				level--
+			}
+		}
+		deepest = max(deepest, depth)
+		expression = expression[min(end, len(expression)):]
+	}
+}
+
+// checkWorkflowLimits reports the size and complexity limits of the provider
+// workflow_limits exceeded by workflows whose content is created or changed by
+// the plan, as errors when the limits are enforced and as warnings otherwise.
+func (r *workflowResource) checkWorkflowLimits(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !r.limits.enabled() {
+		return
+	}
+
+	var plan, state workflowResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.ReadOnly.ValueBool() || plan.Nodes.IsUnknown() || plan.Nodes.IsNull() || plan.Connections.IsUnknown() {
+		return
+	}
+	if plan.Nodes.Equal(state.Nodes) && plan.Connections.Equal(state.Connections) {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	for _, problem := range r.limits.exceeded(nodes, plan.Nodes.ValueString(), plan.Connections.ValueString()) {
+		detail := fmt.Sprintf("%s Large workflows slow down the n8n editor and executions. Split the workflow, for instance with sub-workflows, or raise the provider workflow_limits.", problem)
+		if r.limits.enforce {
+			resp.Diagnostics.AddAttributeError(path.Root("nodes"), "Workflow limit exceeded", detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(path.Root("nodes"), "Workflow limit exceeded", detail)
+		}
+	}
+}
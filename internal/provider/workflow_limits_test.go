@@ -0,0 +1,61 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpressionDepth(t *testing.T) {
+	tests := map[string]int{
+		"plain text":        0,
+		"{{ $json.id }}":    1,
+		"{{ $json['id'] }}": 2,
+		"{{ $json.items.map(i => ({ id: i.id })) }}":     4,
+		"{{ $json.a }} and {{ $('Node').item.json[0] }}": 2,
+		"{{ unterminated (":                              2,
+	}
+	for expression, depth := range tests {
+		assert.Equal(t, depth, expressionDepth(expression), expression)
+	}
+
+	parameters := map[string]interface{}{
+		"text":   "={{ $json.name }}",
+		"nested": []interface{}{map[string]interface{}{"value": "={{ $json.items.filter(i => i.ok) }}"}},
+		"plain":  "{{ not an expression (( }}",
+	}
+	assert.Equal(t, 2, parameterExpressionDepth(parameters))
+}
+
+func TestWorkflowLimitsExceeded(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Start", Type: "n8n-nodes-base.manualTrigger"},
+		{Name: "Set", Type: "n8n-nodes-base.set", Parameters: map[string]interface{}{"value": "={{ $json.items.map(i => i.id) }}"}},
+		{Name: "Note", Type: stickyNoteNodeType},
+	}
+	nodesJSON := n8ntest.JSON(t, nodes)
+
+	assert.False(t, workflowLimitsFromModel(nil).enabled())
+	limits := workflowLimitsFromModel(&workflowLimitsModel{
+		MaxNodes:           types.Int64Value(1),
+		MaxSize:            types.Int64Value(10),
+		MaxExpressionDepth: types.Int64Value(1),
+		Enforce:            types.BoolNull(),
+	})
+	assert.True(t, limits.enabled())
+	assert.False(t, limits.enforce)
+
+	exceeded := limits.exceeded(nodes, nodesJSON, `{ }`)
+	assert.Len(t, exceeded, 3)
+	assert.Equal(t, "The workflow has 2 nodes, more than the limit of 1.", exceeded[0])
+	assert.Contains(t, exceeded[1], "The nodes and connections of the workflow weigh")
+	assert.Equal(t, `Node "Set" has an expression nested 2 levels deep, more than the limit of 1.`, exceeded[2])
+
+	assert.Empty(t, workflowLimits{maxNodes: 2, maxSize: 10000, maxExpressionDepth: 2}.exceeded(nodes, nodesJSON, `{}`))
+}
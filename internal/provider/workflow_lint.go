@@ -0,0 +1,124 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ifNodeType          = "n8n-nodes-base.if"
+	httpRequestNodeType = "n8n-nodes-base.httpRequest"
+)
+
+// ifBranches are the names of the outputs of the IF node, in order.
+var ifBranches = []string{"true", "false"}
+
+// lintPlannedWorkflow warns about common problems in the content of workflows
+// created or changed by the plan, when the lint_workflows provider setting is
+// enabled. Read-only workflows are not written and are not linted.
+func (r *workflowResource) lintPlannedWorkflow(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !r.lintWorkflows {
+		return
+	}
+
+	var plan, state workflowResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.ReadOnly.ValueBool() || plan.Nodes.IsUnknown() || plan.Nodes.IsNull() || plan.Connections.IsUnknown() {
+		return
+	}
+
+	errorWorkflow, stateErrorWorkflow := types.StringNull(), types.StringNull()
+	if plan.Settings != nil {
+		errorWorkflow = plan.Settings.ErrorWorkflow
+	}
+	if state.Settings != nil {
+		stateErrorWorkflow = state.Settings.ErrorWorkflow
+	}
+	if plan.Nodes.Equal(state.Nodes) && plan.Connections.Equal(state.Connections) && plan.Active.Equal(state.Active) && errorWorkflow.Equal(stateErrorWorkflow) {
+		return
+	}
+
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+
+	// An unknown error workflow is set once known
+	hasErrorWorkflow := errorWorkflow.IsUnknown() || errorWorkflow.ValueString() != ""
+	for _, problem := range lintWorkflow(nodes, plan.Connections.ValueString(), plan.Active.ValueBool(), hasErrorWorkflow) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("nodes"), "Workflow lint", problem)
+	}
+}
+
+// lintWorkflow returns a description of each common problem of the content of
+// a workflow: nodes connected to no other node, IF nodes with a branch leading
+// nowhere, HTTP Request nodes without a timeout, and active schedules without
+// an error workflow to report their failures.
+func lintWorkflow(nodes []n8n.Node, connectionsJSON string, active, hasErrorWorkflow bool) []string {
+	connected := connectedNodeNames(connectionsJSON)
+	outputs := nodeOutputs(connectionsJSON)
+
+	var problems []string
+	var schedules []string
+	for _, node := range nodes {
+		if node.Type == stickyNoteNodeType {
+			continue
+		}
+		if len(nodes) > 1 && !connected[node.Name] {
+			problems = append(problems, fmt.Sprintf("Node %q is not connected to any other node, so it never runs.", node.Name))
+		}
+
+		switch node.Type {
+		case ifNodeType:
+			// Unconnected IF nodes are already reported
+			for i, branch := range ifBranches {
+				if connected[node.Name] && (i >= len(outputs[node.Name]) || outputs[node.Name][i] == 0) {
+					problems = append(problems, fmt.Sprintf("IF node %q has no node connected to its %s branch, so items taking it are dropped.", node.Name, branch))
+				}
+			}
+		case httpRequestNodeType:
+			if options, _ := node.Parameters["options"].(map[string]interface{}); options["timeout"] == nil {
+				problems = append(problems, fmt.Sprintf("HTTP Request node %q has no timeout, so an unresponsive server stalls the execution until the workflow times out. Set options.timeout.", node.Name))
+			}
+		case scheduleTriggerNodeType, cronNodeType:
+			schedules = append(schedules, fmt.Sprintf("%q", node.Name))
+		}
+	}
+
+	if active && !hasErrorWorkflow && len(schedules) > 0 {
+		problems = append(problems, fmt.Sprintf("The workflow runs on a schedule (%s) but has no error workflow, so failed scheduled executions go unnoticed. Set settings.error_workflow.", strings.Join(schedules, ", ")))
+	}
+	return problems
+}
+
+// nodeOutputs returns, for each source node of a JSON-encoded connections
+// object, the number of connections of each of its main outputs.
+func nodeOutputs(connectionsJSON string) map[string][]int {
+	var connections map[string]struct {
+		Main [][]json.RawMessage `json:"main"`
+	}
+	if err := json.Unmarshal([]byte(connectionsJSON), &connections); err != nil {
+		return nil
+	}
+
+	outputs := make(map[string][]int, len(connections))
+	for source, connection := range connections {
+		for _, targets := range connection.Main {
+			outputs[source] = append(outputs[source], len(targets))
+		}
+	}
+	return outputs
+}
@@ -0,0 +1,45 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintWorkflow(t *testing.T) {
+	nodes := []n8n.Node{
+		{Name: "Every hour", Type: scheduleTriggerNodeType},
+		{Name: "Fetch", Type: httpRequestNodeType, Parameters: map[string]interface{}{"url": "https://example.com"}},
+		{Name: "Fetch with timeout", Type: httpRequestNodeType, Parameters: map[string]interface{}{"options": map[string]interface{}{"timeout": float64(10000)}}},
+		{Name: "Has items", Type: ifNodeType},
+		{Name: "Notify", Type: "n8n-nodes-base.slack"},
+		{Name: "Leftover", Type: "n8n-nodes-base.set"},
+		{Name: "Note", Type: stickyNoteNodeType},
+	}
+	connections := `{
+		"Every hour": {"main": [[{"node": "Fetch", "type": "main", "index": 0}]]},
+		"Fetch": {"main": [[{"node": "Fetch with timeout", "type": "main", "index": 0}]]},
+		"Fetch with timeout": {"main": [[{"node": "Has items", "type": "main", "index": 0}]]},
+		"Has items": {"main": [[{"node": "Notify", "type": "main", "index": 0}], []]}
+	}`
+
+	problems := lintWorkflow(nodes, connections, true, false)
+	assert.Equal(t, []string{
+		`HTTP Request node "Fetch" has no timeout, so an unresponsive server stalls the execution until the workflow times out. Set options.timeout.`,
+		`IF node "Has items" has no node connected to its false branch, so items taking it are dropped.`,
+		`Node "Leftover" is not connected to any other node, so it never runs.`,
+		`The workflow runs on a schedule ("Every hour") but has no error workflow, so failed scheduled executions go unnoticed. Set settings.error_workflow.`,
+	}, problems)
+
+	// Inactive workflows and workflows with an error workflow are not
+	// reported for their schedules
+	assert.Len(t, lintWorkflow(nodes, connections, false, false), 3)
+	assert.Len(t, lintWorkflow(nodes, connections, true, true), 3)
+
+	// A lone node is not reported as unconnected
+	assert.Empty(t, lintWorkflow([]n8n.Node{{Name: "Start", Type: "n8n-nodes-base.manualTrigger"}}, `{}`, false, false))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// workflowNamePolicy holds the prefix and suffix the provider adds to the
+// names of the workflows it manages, such as "dev-" for workflows of a
+// development environment sharing an instance with other environments.
+type workflowNamePolicy struct {
+	prefix string
+	suffix string
+}
+
+// apply returns the name stored in n8n for a configured workflow name.
+func (p workflowNamePolicy) apply(name string) string {
+	return p.prefix + name + p.suffix
+}
+
+// strip returns the configured workflow name for a name stored in n8n. Names
+// missing the prefix or suffix are returned unchanged, so that the difference
+// shows up in the plan and the policy is enforced on the next apply.
+func (p workflowNamePolicy) strip(name string) string {
+	if len(name) < len(p.prefix)+len(p.suffix) || !strings.HasPrefix(name, p.prefix) || !strings.HasSuffix(name, p.suffix) {
+		return name
+	}
+	return name[len(p.prefix) : len(name)-len(p.suffix)]
+}
+
+// equivalentNames reports whether two workflow names only differ by letter
+// case or whitespace, such as "Sync  orders" and "sync orders".
+func equivalentNames(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
+
+// keepEquivalentName returns kept when normalize is set and both names are
+// equivalent, and name otherwise. With normalize_name set, names read from n8n
+// keep the case and whitespace of the configuration, and names written to n8n
+// keep the ones of the workflow in n8n.
+func keepEquivalentName(normalize bool, name, kept string) string {
+	if normalize && equivalentNames(name, kept) {
+		return kept
+	}
+	return name
+}
+
+// warnAboutRename reports a plan renaming a workflow. Renames keep the ID and
+// the webhook URLs of the workflow but change how it shows up in the n8n
+// editor and in error notifications, so accidental ones are easily missed.
+// Names only differing by case or whitespace are usually an import of a
+// workflow named by hand, which normalize_name leaves as is.
+func warnAboutRename(plan, state workflowResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.Name.IsUnknown() || plan.Name.Equal(state.Name) || state.Name.IsNull() {
+		return
+	}
+
+	detail := fmt.Sprintf("Workflow %s will be renamed from %q to %q. Its ID and webhook URLs do not change.", state.ID.ValueString(), state.Name.ValueString(), plan.Name.ValueString())
+	if equivalentNames(plan.Name.ValueString(), state.Name.ValueString()) {
+		detail += " The names only differ by letter case or whitespace: set normalize_name to keep the name as it is in n8n."
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("name"), "Workflow will be renamed", detail)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "testing"
+
+func TestWorkflowNamePolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   workflowNamePolicy
+		stored   string
+		expected string
+	}{
+		{
+			name:     "no policy",
+			stored:   "Orders",
+			expected: "Orders",
+		},
+		{
+			name:     "prefix and suffix stripped",
+			policy:   workflowNamePolicy{prefix: "dev-", suffix: " (tf)"},
+			stored:   "dev-Orders (tf)",
+			expected: "Orders",
+		},
+		{
+			name:     "missing prefix kept",
+			policy:   workflowNamePolicy{prefix: "dev-", suffix: " (tf)"},
+			stored:   "Orders (tf)",
+			expected: "Orders (tf)",
+		},
+		{
+			name:     "missing suffix kept",
+			policy:   workflowNamePolicy{prefix: "dev-"},
+			stored:   "staging-Orders",
+			expected: "staging-Orders",
+		},
+		{
+			name:     "overlapping prefix and suffix kept",
+			policy:   workflowNamePolicy{prefix: "ab", suffix: "bc"},
+			stored:   "abc",
+			expected: "abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.strip(tt.stored); got != tt.expected {
+				t.Errorf("strip(%q) = %q, want %q", tt.stored, got, tt.expected)
+			}
+			if tt.expected != tt.stored {
+				if got := tt.policy.apply(tt.expected); got != tt.stored {
+					t.Errorf("apply(%q) = %q, want %q", tt.expected, got, tt.stored)
+				}
+			}
+		})
+	}
+}
+
+func TestKeepEquivalentName(t *testing.T) {
+	if !equivalentNames("Sync  orders", " sync Orders") {
+		t.Errorf("expected names differing by case and whitespace to be equivalent")
+	}
+	if equivalentNames("Sync orders", "Sync order") {
+		t.Errorf("expected different names not to be equivalent")
+	}
+
+	if got := keepEquivalentName(true, "sync orders", "Sync Orders"); got != "Sync Orders" {
+		t.Errorf("expected the equivalent name to be kept, got %q", got)
+	}
+	if got := keepEquivalentName(false, "sync orders", "Sync Orders"); got != "sync orders" {
+		t.Errorf("expected the name without normalize_name, got %q", got)
+	}
+	if got := keepEquivalentName(true, "Billing", "Sync Orders"); got != "Billing" {
+		t.Errorf("expected a different name to be used, got %q", got)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// protectedTagsOf returns the names of the given tags that protect a workflow
+// from being deleted.
+func protectedTagsOf(tags []n8n.Tag, protected []string) []string {
+	var matched []string
+	for _, tag := range tags {
+		for _, name := range protected {
+			if tag.Name == name {
+				matched = append(matched, tag.Name)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// protectedWorkflowDetail describes why a workflow carrying protected tags
+// cannot be deleted.
+func protectedWorkflowDetail(workflow *n8n.Workflow, tags []string) string {
+	return fmt.Sprintf("Workflow %q (%s) carries the protected tag %s, listed in the provider protected_tags. Remove the tag in n8n, or remove it from protected_tags, to delete or replace the workflow.", workflow.Name, workflow.ID, strings.Join(tags, ", "))
+}
+
+// refuseProtectedDestroy fails the plan of a workflow that would be deleted
+// or replaced while it carries a protected tag. The tags are read from n8n,
+// since they are set in the editor rather than in configuration.
+func (r *workflowResource) refuseProtectedDestroy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if len(r.protectedTags) == 0 {
+		return
+	}
+
+	var state workflowResourceModel
+	if diags := req.State.Get(ctx, &state); diags.HasError() || state.ReadOnly.ValueBool() {
+		return
+	}
+
+	client, err := r.clientFor(ctx, state.Endpoint)
+	if err != nil || client == nil {
+		return
+	}
+
+	workflow, err := client.GetWorkflow(state.ID.ValueString())
+	if err != nil {
+		// The delete checks the tags again
+		tflog.Debug(ctx, "Unable to check the workflow for protected tags", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if tags := protectedTagsOf(workflow.Tags, r.protectedTags); len(tags) > 0 {
+		resp.Diagnostics.AddError("Protected workflow", protectedWorkflowDetail(workflow, tags))
+	}
+}
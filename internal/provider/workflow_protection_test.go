@@ -0,0 +1,20 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtectedTagsOf(t *testing.T) {
+	tags := []n8n.Tag{{ID: "1", Name: "billing"}, {ID: "2", Name: "protected"}}
+
+	assert.Equal(t, []string{"protected"}, protectedTagsOf(tags, []string{"protected", "critical"}))
+	assert.Empty(t, protectedTagsOf(tags, []string{"Protected"}), "tag names are matched exactly")
+	assert.Empty(t, protectedTagsOf(tags, nil))
+	assert.Empty(t, protectedTagsOf(nil, []string{"protected"}))
+}
@@ -4,51 +4,99 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &workflowResource{}
-	_ resource.ResourceWithConfigure   = &workflowResource{}
-	_ resource.ResourceWithImportState = &workflowResource{}
-	_ resource.ResourceWithModifyPlan  = &workflowResource{}
+	_ resource.Resource                   = &workflowResource{}
+	_ resource.ResourceWithConfigure      = &workflowResource{}
+	_ resource.ResourceWithImportState    = &workflowResource{}
+	_ resource.ResourceWithModifyPlan     = &workflowResource{}
+	_ resource.ResourceWithValidateConfig = &workflowResource{}
 )
 
+// workflowETagKey is the private state key of the entity tag returned by the
+// last read of a workflow, for instances that support conditional requests.
+const workflowETagKey = "etag"
+
 // NewWorkflowResource returns a new resource.
 func NewWorkflowResource() resource.Resource {
 	return &workflowResource{}
 }
 
 type workflowResource struct {
-	client *n8n.Client
+	client              *n8n.Client
+	workflowNames       workflowNamePolicy
+	managedTag          string
+	protectedTags       []string
+	lintWorkflows       bool
+	disallowedNodeTypes []string
+	credentialTypes     credentialTypePolicy
+	limits              workflowLimits
 }
 
 // workflowResourceModel maps the resource schema data.
 type workflowResourceModel struct {
-	ID           types.String          `tfsdk:"id"`
-	Name         types.String          `tfsdk:"name"`
-	Active       types.Bool            `tfsdk:"active"`
-	Nodes        types.String          `tfsdk:"nodes"`
-	Connections  types.String          `tfsdk:"connections"`
-	Settings     *settingsResourceModel `tfsdk:"settings"`
-	VersionId    types.String          `tfsdk:"version_id"`
-	CreatedAt    types.String          `tfsdk:"created_at"`
-	UpdatedAt    types.String          `tfsdk:"updated_at"`
+	ID                types.String           `tfsdk:"id"`
+	Name              types.String           `tfsdk:"name"`
+	Active            types.Bool             `tfsdk:"active"`
+	Nodes             types.String           `tfsdk:"nodes"`
+	Connections       types.String           `tfsdk:"connections"`
+	Settings          *settingsResourceModel `tfsdk:"settings"`
+	ExtraFields       types.String           `tfsdk:"extra_fields"`
+	VersionId         types.String           `tfsdk:"version_id"`
+	CreatedAt         types.String           `tfsdk:"created_at"`
+	UpdatedAt         types.String           `tfsdk:"updated_at"`
+	ContentHash       types.String           `tfsdk:"content_hash"`
+	TriggerCount      types.Int64            `tfsdk:"trigger_count"`
+	CalledWorkflowIDs types.List             `tfsdk:"called_workflow_ids"`
+
+	IgnoreEmptyParameters types.Bool                `tfsdk:"ignore_empty_parameters"`
+	IgnorePaths           *ignorePathsResourceModel `tfsdk:"ignore_paths"`
+	IgnoreStickyNotes     types.Bool                `tfsdk:"ignore_sticky_notes"`
+	ReadOnly              types.Bool                `tfsdk:"read_only"`
+	AdoptExistingByName   types.Bool                `tfsdk:"adopt_existing_by_name"`
+	VerifyActivation      types.Bool                `tfsdk:"verify_activation"`
+	ValidateOnPlan        types.Bool                `tfsdk:"validate_on_plan"`
+	WaitForWebhooks       types.Bool                `tfsdk:"wait_for_webhooks"`
+	Force                 types.Bool                `tfsdk:"force"`
+	NormalizeName         types.Bool                `tfsdk:"normalize_name"`
+	ErrorWorkflowName     types.String              `tfsdk:"error_workflow_name"`
+	ActivateAfter         types.List                `tfsdk:"activate_after"`
+	SubworkflowOverrides  types.Map                 `tfsdk:"subworkflow_overrides"`
+	Endpoint              *endpointResourceModel    `tfsdk:"endpoint"`
+}
+
+type endpointResourceModel struct {
+	Host  types.String `tfsdk:"host"`
+	Token types.String `tfsdk:"token"`
+}
+
+type ignorePathsResourceModel struct {
+	Nodes       types.List `tfsdk:"nodes"`
+	Connections types.List `tfsdk:"connections"`
 }
 
 type settingsResourceModel struct {
@@ -62,16 +110,83 @@ type settingsResourceModel struct {
 	ExecutionOrder           types.String `tfsdk:"execution_order"`
 }
 
+// settingsResourceAttrTypes are the attribute types of the settings object.
+var settingsResourceAttrTypes = map[string]attr.Type{
+	"save_execution_progress":     types.BoolType,
+	"save_manual_executions":      types.BoolType,
+	"save_data_error_execution":   types.StringType,
+	"save_data_success_execution": types.StringType,
+	"execution_timeout":           types.Int64Type,
+	"error_workflow":              types.StringType,
+	"timezone":                    types.StringType,
+	"execution_order":             types.StringType,
+}
+
+// defaultWorkflowSettings returns the settings applied when none are configured.
+func defaultWorkflowSettings() n8n.Settings {
+	return n8n.Settings{
+		SaveExecutionProgress:    n8n.Ptr(true),
+		SaveManualExecutions:     n8n.Ptr(true),
+		SaveDataErrorExecution:   n8n.Ptr("all"),
+		SaveDataSuccessExecution: n8n.Ptr("all"),
+		ExecutionTimeout:         n8n.Ptr(3600),
+		ErrorWorkflow:            n8n.Ptr(""),
+		Timezone:                 n8n.Ptr("America/New_York"),
+		ExecutionOrder:           n8n.Ptr("v1"),
+	}
+}
+
+// workflowSettingsFromModel returns the given settings overridden by the
+// configured settings, if any. Null settings are left unset, so
+// that they are not sent to n8n.
+func workflowSettingsFromModel(settings n8n.Settings, model *settingsResourceModel) n8n.Settings {
+	if model != nil {
+		settings.SaveExecutionProgress = model.SaveExecutionProgress.ValueBoolPointer()
+		settings.SaveManualExecutions = model.SaveManualExecutions.ValueBoolPointer()
+		settings.SaveDataErrorExecution = model.SaveDataErrorExecution.ValueStringPointer()
+		settings.SaveDataSuccessExecution = model.SaveDataSuccessExecution.ValueStringPointer()
+		settings.ExecutionTimeout = nil
+		if timeout := model.ExecutionTimeout.ValueInt64Pointer(); timeout != nil {
+			settings.ExecutionTimeout = n8n.Ptr(int(*timeout))
+		}
+		settings.ErrorWorkflow = model.ErrorWorkflow.ValueStringPointer()
+		settings.Timezone = model.Timezone.ValueStringPointer()
+		settings.ExecutionOrder = model.ExecutionOrder.ValueStringPointer()
+	}
+	return settings
+}
+
+// settingsResourceValue converts workflow settings to a settings object value.
+func settingsResourceValue(settings n8n.Settings) types.Object {
+	return types.ObjectValueMust(settingsResourceAttrTypes, map[string]attr.Value{
+		"save_execution_progress":     types.BoolValue(settings.GetSaveExecutionProgress()),
+		"save_manual_executions":      types.BoolValue(settings.GetSaveManualExecutions()),
+		"save_data_error_execution":   types.StringValue(settings.GetSaveDataErrorExecution()),
+		"save_data_success_execution": types.StringValue(settings.GetSaveDataSuccessExecution()),
+		"execution_timeout":           types.Int64Value(int64(settings.GetExecutionTimeout())),
+		"error_workflow":              types.StringValue(settings.GetErrorWorkflow()),
+		"timezone":                    types.StringValue(settings.GetTimezone()),
+		"execution_order":             types.StringValue(settings.GetExecutionOrder()),
+	})
+}
+
 func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*n8n.Client)
+	data, ok := req.ProviderData.(*resourceProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
 		return
 	}
-	r.client = client
+	r.client = data.client
+	r.workflowNames = data.workflowNames
+	r.managedTag = data.managedTag
+	r.protectedTags = data.protectedTags
+	r.lintWorkflows = data.lintWorkflows
+	r.disallowedNodeTypes = data.disallowedNodeTypes
+	r.credentialTypes = data.credentialTypes
+	r.limits = data.limits
 }
 
 func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,6 +196,7 @@ func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequ
 func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an n8n workflow.",
+		Version:     workflowResourceSchemaVersion,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -91,7 +207,7 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the workflow.",
+				Description: "Name of the workflow, without the provider `workflow_name_prefix` and `workflow_name_suffix`.",
 			},
 			"active": schema.BoolAttribute{
 				Optional:    true,
@@ -100,78 +216,231 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Whether the workflow is active.",
 			},
 			"nodes": schema.StringAttribute{
-				Required:    true,
-				Description: "JSON-encoded array of workflow nodes.",
+				Optional:    true,
+				Computed:    true,
+				Description: "JSON-encoded array of workflow nodes. Values read from n8n are stored in state in canonical form: compact JSON with object keys sorted alphabetically. Omit both `nodes` and `connections` to leave the content of the workflow to the n8n editor and only manage its name, activation and settings. Such a workflow must be imported, or adopted with `adopt_existing_by_name`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					JSONSemanticEquality(),
 				},
 			},
 			"connections": schema.StringAttribute{
-				Required:    true,
-				Description: "JSON-encoded connections between nodes.",
+				Optional:    true,
+				Computed:    true,
+				Description: "JSON-encoded connections between nodes. Values read from n8n are stored in state in canonical form: compact JSON with object keys sorted alphabetically. Set together with `nodes`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					JSONSemanticEquality(),
 				},
 			},
 			"settings": schema.SingleNestedAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Workflow execution settings.",
+				Default:     objectdefault.StaticValue(settingsResourceValue(defaultWorkflowSettings())),
+				Description: "Workflow execution settings. Settings left out of the configuration take their default when the workflow is created, then keep the value read from n8n, such as a timezone inherited from the instance, instead of being reset to the default.",
+				PlanModifiers: []planmodifier.Object{
+					inheritedSetting(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"save_execution_progress": schema.BoolAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     booldefault.StaticBool(true),
 						Description: "Whether to save execution progress.",
+						PlanModifiers: []planmodifier.Bool{
+							inheritedSetting(),
+						},
 					},
 					"save_manual_executions": schema.BoolAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     booldefault.StaticBool(true),
 						Description: "Whether to save manual executions.",
+						PlanModifiers: []planmodifier.Bool{
+							inheritedSetting(),
+						},
 					},
 					"save_data_error_execution": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("all"),
 						Description: "Save behavior for error executions: 'all' or 'none'.",
+						PlanModifiers: []planmodifier.String{
+							inheritedSetting(),
+						},
 					},
 					"save_data_success_execution": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("all"),
 						Description: "Save behavior for successful executions: 'all' or 'none'.",
+						PlanModifiers: []planmodifier.String{
+							inheritedSetting(),
+						},
 					},
 					"execution_timeout": schema.Int64Attribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     int64default.StaticInt64(3600),
-						Description: "Execution timeout in seconds (max 3600).",
+						Description: "Execution timeout in seconds (max 3600), or -1 for no timeout.",
+						PlanModifiers: []planmodifier.Int64{
+							inheritedSetting(),
+						},
 					},
 					"error_workflow": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString(""),
 						Description: "ID of the error handler workflow.",
+						PlanModifiers: []planmodifier.String{
+							inheritedSetting(),
+						},
 					},
 					"timezone": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("America/New_York"),
 						Description: "Timezone for the workflow.",
+						PlanModifiers: []planmodifier.String{
+							inheritedSetting(),
+						},
 					},
 					"execution_order": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("v1"),
-						Description: "Execution order version.",
+						Description: "Execution order version: `v1` (default) runs each branch to completion before the next one, `v0` is the legacy order of workflows created before n8n 1.0.",
+						PlanModifiers: []planmodifier.String{
+							inheritedSetting(),
+						},
+						Validators: []validator.String{
+							StringOneOf("v0", "v1"),
+						},
 					},
 				},
 			},
+			"extra_fields": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON object of workflow fields not modeled by this resource, such as fields added by newer n8n versions, sent along with the workflow on create and update. Fields not set here are left unchanged by updates. The values of the configured fields are read back from n8n to detect drift. The read-only fields n8n returns, such as `shared`, `isArchived` or `meta`, cannot be set, as the API rejects updates sending them.",
+				PlanModifiers: []planmodifier.String{
+					JSONSemanticEquality(),
+				},
+				Validators: []validator.String{
+					ExtraWorkflowFields(),
+				},
+			},
 			"version_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Workflow version ID. Changes on every workflow update.",
 			},
+			"ignore_empty_parameters": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Treat empty strings, objects and arrays inside node parameters as equivalent to missing keys when comparing nodes. Useful for nodes such as Set and IF, where n8n adds or drops empty parameters on save.",
+			},
+			"ignore_paths": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "JSON pointers of values managed outside Terraform, such as by the server or by UI users. Matched values are excluded from drift detection and are kept as they are on the server on update. A `*` segment matches any array index or object key.",
+				Attributes: map[string]schema.Attribute{
+					"nodes": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "JSON pointers relative to the `nodes` array, e.g. `/*/parameters/options/timezone`, or `/*/disabled` to leave nodes enabled or disabled as set in the UI. Nodes are matched by name.",
+						Validators: []validator.List{
+							JSONPointers(),
+						},
+					},
+					"connections": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "JSON pointers relative to the `connections` object.",
+						Validators: []validator.List{
+							JSONPointers(),
+						},
+					},
+				},
+			},
+			"ignore_sticky_notes": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Leave canvas sticky notes to UI users. Sticky note nodes are excluded from drift detection, sticky notes in `nodes` are only used when creating the workflow, and updates keep the sticky notes stored on the server. When false, sticky notes are managed like any other node.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Only detect drift, never write to n8n. Differences between the configuration and n8n are still shown in plans, but applying them does not change the workflow, and destroying the resource only removes it from state. A read-only workflow cannot be created and must be imported.",
+			},
+			"adopt_existing_by_name": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On create, take over the workflow with the same name instead of creating a duplicate, updating it to match the configuration. Creating fails when several workflows have that name. Only use it for workflows not managed by another Terraform configuration.",
+			},
+			"verify_activation": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "After writing an active workflow, read it again after a short delay and fail the apply if n8n deactivated it, which happens when a trigger cannot be registered, e.g. an invalid cron expression or a conflicting webhook path.",
+			},
+			"wait_for_webhooks": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "After writing an active workflow, wait up to a minute for n8n to serve the production URL of each Webhook node before completing the apply, so that resources registering the URL elsewhere do not point at an endpoint that is not live yet. The URLs are checked with CORS preflight requests, which do not execute the workflow, against the provider host. Paths with route parameters are not checked.",
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Check new and changed workflows against the instance at plan time, so that errors n8n reports for the nodes, connections or settings fail the plan instead of the apply. As the public API has no validation endpoint, the provider creates an inactive copy of the workflow, named after it with a `[terraform validation] ` prefix, and deletes it right away. The copy is never activated, but it briefly shows up in the workflow list and is left behind if the deletion fails, which is reported as a warning. Workflows are not validated, with a warning, when the provider has `read_only` set.",
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Overwrite the workflow even if it was modified outside Terraform since the last refresh. By default, an update fails when the version of the workflow in n8n differs from the one in state, e.g. when a colleague edits it in the n8n editor between the plan and the apply. Workflows without nodes are not checked, as their content is left to the editor.",
+			},
+			"normalize_name": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Treat names only differing by letter case or whitespace, such as `Sync  orders` and `sync orders`, as the same name. The name of an imported workflow is then left as it is in n8n instead of being updated to the configured one, and the configured name is kept in state. Other renames are always written, and reported by a warning on plan.",
+			},
+			"error_workflow_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the workflow handling the errors of this workflow, resolved at plan time to the ID written to settings.error_workflow. The provider name prefix and suffix are applied before the lookup, so the name of an n8n_workflow resource can be used as is. The plan fails if several workflows have the name, and warns if the workflow has no Error Trigger node. When no workflow has the name yet, the ID is resolved at apply time, so that referencing the name of an n8n_workflow resource created in the same apply orders it first; the apply fails if the workflow still does not exist. Conflicts with settings.error_workflow.",
+			},
+			"activate_after": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of workflows, such as the error workflow or sub-workflows of this one, that must be active before this workflow is activated. Before activating the workflow, the provider waits for each of them to be active, for up to 2 minutes, so that workflows activated in the same apply without referencing each other are activated in order. The apply fails if one of them stays inactive.",
+			},
+			"subworkflow_overrides": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the workflows called by Execute Workflow or Call n8n Workflow Tool nodes, keyed by node name, written to the nodes at apply time in place of the IDs set in nodes. Reference the id of another n8n_workflow resource to deploy workflows calling each other to any environment. The IDs set in nodes are kept in state, and the plan fails if a node is missing or does not call a workflow.",
+			},
+			"endpoint": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "n8n instance managing this workflow, overriding the provider configuration. Useful to manage workflows of many instances with for_each without a provider alias per instance. Clients are shared between workflows of the same instance. The token is stored in state.",
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Required:    true,
+						Description: "URI for n8n API. Changing the host creates the workflow on the new instance.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"token": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "Token for n8n API.",
+					},
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the workflow was created.",
@@ -183,11 +452,31 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				Description: "Timestamp when the workflow was last updated. Changes on every workflow update.",
 			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: contentHashDescription,
+			},
+			"trigger_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of triggers n8n registered for the workflow. Zero while the workflow is inactive.",
+			},
+			"called_workflow_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted IDs of the workflows called by the enabled Execute Workflow and Call n8n Workflow Tool nodes of the workflow as stored in n8n, after subworkflow_overrides are applied. IDs set by an expression are left out.",
+			},
 		},
 	}
 }
 
 func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	ctx, logAPICalls := trackAPICalls(ctx, "create")
+	defer logAPICalls()
+
 	var plan workflowResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -195,89 +484,193 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Parse nodes from JSON
-	var nodes []n8n.Node
-	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
-		resp.Diagnostics.AddError("Invalid nodes JSON", err.Error())
+	if plan.ReadOnly.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Cannot create a read-only workflow",
+			"Read-only workflows are never written to n8n. Import the existing workflow instead, or set read_only to false to create it.",
+		)
 		return
 	}
 
-	// Parse connections from JSON
+	// Without nodes, only the metadata of an adopted workflow is managed
+	contentUnmanaged := workflowContentUnmanaged(ctx, req.Config)
+
+	var nodes []n8n.Node
 	var connections map[string]n8n.Connection
-	if err := json.Unmarshal([]byte(plan.Connections.ValueString()), &connections); err != nil {
-		resp.Diagnostics.AddError("Invalid connections JSON", err.Error())
+	if !contentUnmanaged {
+		// Parse nodes from JSON
+		if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+			resp.Diagnostics.AddError("Invalid nodes JSON", err.Error())
+			return
+		}
+
+		// Point sub-workflow nodes at the workflows of subworkflow_overrides
+		if err := overrideSubworkflows(nodes, subworkflowOverrides(ctx, plan.SubworkflowOverrides)); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subworkflow_overrides"), "Invalid sub-workflow override", err.Error())
+			return
+		}
+
+		// Parse connections from JSON
+		if err := json.Unmarshal([]byte(plan.Connections.ValueString()), &connections); err != nil {
+			resp.Diagnostics.AddError("Invalid connections JSON", err.Error())
+			return
+		}
+	}
+
+	client, err := r.clientFor(ctx, plan.Endpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create n8n API Client", err.Error())
+		return
+	}
+
+	// Resolve an error workflow created earlier in the same apply
+	if err := r.resolveDeferredErrorWorkflow(client, &plan); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("error_workflow_name"), "Unable to resolve error workflow", err.Error())
 		return
 	}
 
 	// Build settings
-	settings := n8n.Settings{
-		SaveExecutionProgress:    true,
-		SaveManualExecutions:     true,
-		SaveDataErrorExecution:   "all",
-		SaveDataSuccessExecution: "all",
-		ExecutionTimeout:         3600,
-		ErrorWorkflow:            "",
-		Timezone:                 "America/New_York",
-		ExecutionOrder:           "v1",
-	}
-	if plan.Settings != nil {
-		settings.SaveExecutionProgress = plan.Settings.SaveExecutionProgress.ValueBool()
-		settings.SaveManualExecutions = plan.Settings.SaveManualExecutions.ValueBool()
-		settings.SaveDataErrorExecution = plan.Settings.SaveDataErrorExecution.ValueString()
-		settings.SaveDataSuccessExecution = plan.Settings.SaveDataSuccessExecution.ValueString()
-		settings.ExecutionTimeout = int(plan.Settings.ExecutionTimeout.ValueInt64())
-		settings.ErrorWorkflow = plan.Settings.ErrorWorkflow.ValueString()
-		settings.Timezone = plan.Settings.Timezone.ValueString()
-		settings.ExecutionOrder = plan.Settings.ExecutionOrder.ValueString()
+	settings := workflowSettingsFromModel(defaultWorkflowSettings(), plan.Settings)
+
+	extraFields, err := decodeExtraFields(plan.ExtraFields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("extra_fields"), "Invalid extra_fields JSON", err.Error())
+		return
 	}
 
 	// Create workflow
 	createReq := &n8n.CreateWorkflowRequest{
-		Name:        plan.Name.ValueString(),
+		Name:        r.workflowNames.apply(plan.Name.ValueString()),
 		Nodes:       nodes,
 		Connections: connections,
 		Settings:    settings,
+		Extra:       extraFields,
 	}
 
 	tflog.Debug(ctx, "Creating workflow", map[string]any{"name": plan.Name.ValueString()})
 
-	workflow, err := r.client.CreateWorkflow(createReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating workflow", err.Error())
+	var workflow *n8n.Workflow
+
+	// Take over an existing workflow with the same name
+	if plan.AdoptExistingByName.ValueBool() {
+		existing, err := workflowsNamed(client, createReq.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+			return
+		}
+
+		switch len(existing) {
+		case 0:
+		case 1:
+			tflog.Debug(ctx, "Adopting existing workflow", map[string]any{"id": existing[0].ID, "name": createReq.Name})
+
+			settings.Extra = existing[0].Settings.Extra
+			if contentUnmanaged {
+				nodes, connections = existing[0].Nodes, existing[0].Connections
+			}
+			workflow, err = client.UpdateWorkflow(existing[0].ID, &n8n.UpdateWorkflowRequest{
+				Name:        createReq.Name,
+				Nodes:       nodes,
+				Connections: connections,
+				Settings:    settings,
+				Extra:       extraFields,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Error updating adopted workflow", workflowWriteError(err, nodes))
+				return
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("adopt_existing_by_name"),
+				"Cannot adopt workflow",
+				fmt.Sprintf("Several workflows are named %q: %s. Delete the duplicates or import one of them.", createReq.Name, strings.Join(workflowIDs(existing), ", ")),
+			)
+			return
+		}
+	}
+
+	if workflow == nil && contentUnmanaged {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("nodes"),
+			"Cannot create workflow without nodes",
+			fmt.Sprintf("No workflow named %q was found to adopt. Set nodes and connections to create it, or import the existing workflow.", createReq.Name),
+		)
 		return
 	}
 
-	// Activate if requested
-	if plan.Active.ValueBool() {
-		workflow, err = r.client.ActivateWorkflow(workflow.ID)
+	if workflow == nil {
+		workflow, err = client.CreateWorkflow(createReq)
 		if err != nil {
-			resp.Diagnostics.AddError("Error activating workflow", err.Error())
+			resp.Diagnostics.AddError("Error creating workflow", workflowWriteError(err, nodes))
 			return
 		}
 	}
 
+	// Save the written workflow, with its activation state as written, before
+	// the next steps, so that it is tracked even if they fail and the next
+	// apply converges instead of creating it again
+	created := plan
+	if !workflowToState(workflow, contentUnmanaged, &created, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Activate or deactivate as requested, reporting a failure once the
+	// workflow is saved in state
+	var activationDiag diag.Diagnostic
+	if plan.Active.ValueBool() != workflow.Active {
+		workflow, activationDiag = changeWorkflowActivation(ctx, client, workflow, plan)
+	}
+
+	// Mark the workflow as managed by Terraform
+	if r.managedTag != "" {
+		if err := tagWorkflowAsManaged(client, workflow, r.managedTag); err != nil {
+			resp.Diagnostics.AddWarning("Unable to tag workflow as managed", err.Error())
+		}
+	}
+
+	// Read the workflow back, including its tags, once the instance serves the writes
+	workflow = readWorkflowAfterWrite(ctx, client, workflow)
+
+	// Check that n8n kept the workflow active once its triggers were registered
+	var activationErr error
+	if plan.VerifyActivation.ValueBool() && plan.Active.ValueBool() && activationDiag == nil {
+		workflow, activationErr = verifyWorkflowActivation(ctx, client, workflow)
+	}
+
+	// Wait for the webhooks of the active workflow to be served
+	var webhooksErr error
+	if plan.WaitForWebhooks.ValueBool() && workflow.Active && activationErr == nil {
+		webhooksErr = waitForWebhooks(ctx, client, workflow)
+	}
+
 	// Map response to state
-	plan.ID = types.StringValue(workflow.ID)
-	plan.VersionId = types.StringValue(workflow.VersionId)
-	plan.CreatedAt = types.StringValue(workflow.CreatedAt)
-	plan.UpdatedAt = types.StringValue(workflow.UpdatedAt)
-	plan.Active = types.BoolValue(workflow.Active)
-	plan.Settings = &settingsResourceModel{
-		SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-		SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-		SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-		SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-		ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-		Timezone:                 types.StringValue(workflow.Settings.Timezone),
-		ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
+	if !workflowToState(workflow, contentUnmanaged, &plan, &resp.Diagnostics) {
+		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	if activationDiag != nil {
+		resp.Diagnostics.Append(activationDiag)
+	}
+	if activationErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("active"), "Workflow did not stay active", activationErr.Error())
+	}
+	if webhooksErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_for_webhooks"), "Webhooks not ready", webhooksErr.Error())
+	}
 }
 
 func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, logAPICalls := trackAPICalls(ctx, "read")
+	defer logAPICalls()
+
 	var state workflowResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -285,43 +678,128 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	workflow, err := r.client.GetWorkflow(state.ID.ValueString())
+	client, err := r.clientFor(ctx, state.Endpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create n8n API Client", err.Error())
+		return
+	}
+
+	// Read back the pinned data set through extra_fields, so that drift of
+	// the pinned data is detected
+	if setsPinnedData(state.ExtraFields) {
+		client = client.WithPinnedData()
+	}
+
+	// Send the entity tag of the last read, if any, so that an unchanged
+	// workflow is neither transferred nor decoded again
+	var etag string
+	if data, diags := req.Private.GetKey(ctx, workflowETagKey); !diags.HasError() && data != nil && !state.Nodes.IsNull() {
+		_ = json.Unmarshal(data, &etag)
+	}
+
+	workflow, etag, err := client.GetWorkflowIfChanged(state.ID.ValueString(), etag)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading workflow", err.Error())
 		return
 	}
+	if workflow == nil {
+		tflog.Debug(ctx, "Workflow not modified since the last read, keeping state", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+	if etag != "" {
+		data, _ := json.Marshal(etag)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, workflowETagKey, data)...)
+	}
+
+	calledIDs := calledWorkflowIDs(workflow.Nodes)
+
+	// Keep the configured IDs of sub-workflow nodes still calling their override
+	restoreSubworkflowIDs(workflow.Nodes, state.Nodes.ValueString(), subworkflowOverrides(ctx, state.SubworkflowOverrides))
 
-	// Convert nodes back to JSON
-	nodesJSON, err := json.Marshal(workflow.Nodes)
+	// Convert nodes back to canonical JSON
+	nodesJSON, err := workflowCanonicalJSON(sortNodes(workflow.Nodes))
 	if err != nil {
 		resp.Diagnostics.AddError("Error serializing nodes", err.Error())
 		return
 	}
 
-	// Convert connections back to JSON
-	connectionsJSON, err := json.Marshal(workflow.Connections)
+	// Convert connections back to canonical JSON
+	connectionsJSON, err := workflowCanonicalJSON(workflow.Connections)
 	if err != nil {
 		resp.Diagnostics.AddError("Error serializing connections", err.Error())
 		return
 	}
 
+	contentHash, err := workflowContentHash(workflow)
+	if err != nil {
+		resp.Diagnostics.AddError("Error hashing workflow content", err.Error())
+		return
+	}
+
+	// Report changes made in the n8n editor or by other API clients, unless
+	// the workflow is read-only and such changes are expected
+	if !state.ReadOnly.ValueBool() {
+		if detail := externalChangeDetail(state.VersionId.ValueString(), workflow); detail != "" {
+			resp.Diagnostics.AddWarning("Workflow modified outside Terraform", detail)
+		}
+	}
+
+	extraFields, err := extraFieldsFromWorkflow(state.ExtraFields, workflow.Extra)
+	if err != nil {
+		resp.Diagnostics.AddError("Error serializing extra fields", err.Error())
+		return
+	}
+
 	state.ID = types.StringValue(workflow.ID)
-	state.Name = types.StringValue(workflow.Name)
+	state.Name = types.StringValue(keepEquivalentName(state.NormalizeName.ValueBool(), r.workflowNames.strip(workflow.Name), state.Name.ValueString()))
 	state.Active = types.BoolValue(workflow.Active)
-	state.Nodes = types.StringValue(string(nodesJSON))
-	state.Connections = types.StringValue(string(connectionsJSON))
+	state.Nodes = types.StringValue(nodesJSON)
+	state.Connections = types.StringValue(connectionsJSON)
 	state.VersionId = types.StringValue(workflow.VersionId)
 	state.CreatedAt = types.StringValue(workflow.CreatedAt)
 	state.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+	state.ContentHash = types.StringValue(contentHash)
+	state.TriggerCount = types.Int64Value(int64(workflow.TriggerCount))
+	state.CalledWorkflowIDs = stringListValue(calledIDs)
+	state.ExtraFields = extraFields
 	state.Settings = &settingsResourceModel{
-		SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-		SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-		SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-		SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-		ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-		Timezone:                 types.StringValue(workflow.Settings.Timezone),
-		ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
+		SaveExecutionProgress:    types.BoolValue(workflow.Settings.GetSaveExecutionProgress()),
+		SaveManualExecutions:     types.BoolValue(workflow.Settings.GetSaveManualExecutions()),
+		SaveDataErrorExecution:   types.StringValue(workflow.Settings.GetSaveDataErrorExecution()),
+		SaveDataSuccessExecution: types.StringValue(workflow.Settings.GetSaveDataSuccessExecution()),
+		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.GetExecutionTimeout())),
+		ErrorWorkflow:            types.StringValue(workflow.Settings.GetErrorWorkflow()),
+		Timezone:                 types.StringValue(workflow.Settings.GetTimezone()),
+		ExecutionOrder:           types.StringValue(workflow.Settings.GetExecutionOrder()),
+	}
+
+	// Provider-only options are absent after import, fall back to their defaults
+	if state.IgnoreEmptyParameters.IsNull() {
+		state.IgnoreEmptyParameters = types.BoolValue(false)
+	}
+	if state.IgnoreStickyNotes.IsNull() {
+		state.IgnoreStickyNotes = types.BoolValue(false)
+	}
+	if state.ReadOnly.IsNull() {
+		state.ReadOnly = types.BoolValue(false)
+	}
+	if state.AdoptExistingByName.IsNull() {
+		state.AdoptExistingByName = types.BoolValue(false)
+	}
+	if state.VerifyActivation.IsNull() {
+		state.VerifyActivation = types.BoolValue(false)
+	}
+	if state.ValidateOnPlan.IsNull() {
+		state.ValidateOnPlan = types.BoolValue(false)
+	}
+	if state.WaitForWebhooks.IsNull() {
+		state.WaitForWebhooks = types.BoolValue(false)
+	}
+	if state.Force.IsNull() {
+		state.Force = types.BoolValue(false)
+	}
+	if state.NormalizeName.IsNull() {
+		state.NormalizeName = types.BoolValue(false)
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -329,6 +807,13 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	ctx, logAPICalls := trackAPICalls(ctx, "update")
+	defer logAPICalls()
+
 	var plan workflowResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -343,83 +828,443 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Parse nodes from JSON
-	var nodes []n8n.Node
-	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
-		resp.Diagnostics.AddError("Invalid nodes JSON", err.Error())
+	client, err := r.clientFor(ctx, plan.Endpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create n8n API Client", err.Error())
 		return
 	}
 
-	// Parse connections from JSON
-	var connections map[string]n8n.Connection
-	if err := json.Unmarshal([]byte(plan.Connections.ValueString()), &connections); err != nil {
-		resp.Diagnostics.AddError("Invalid connections JSON", err.Error())
+	// Resolve an error workflow created earlier in the same apply before the
+	// comparison, so that an unknown error workflow is never saved to state
+	if err := r.resolveDeferredErrorWorkflow(client, &plan); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("error_workflow_name"), "Unable to resolve error workflow", err.Error())
 		return
 	}
 
+	// Values that were unknown at plan time may have resolved to the values
+	// already stored in n8n, in which case there is nothing to update
+	nodesOpts := jsonSemanticOptionsFromConfig(ctx, req.Config, "nodes")
+	connectionsOpts := jsonSemanticOptionsFromConfig(ctx, req.Config, "connections")
+	diff := compareWorkflowContent(plan, state, nodesOpts, connectionsOpts)
+	if !diff.changed || plan.ReadOnly.ValueBool() {
+		if diff.changed {
+			resp.Diagnostics.AddWarning(
+				"Read-only workflow not updated",
+				fmt.Sprintf("Workflow %s differs from the configuration in: %s. The changes were not written to n8n because read_only is set, and will be reported again on the next plan.", state.ID.ValueString(), strings.Join(diff.changedFields, ", ")),
+			)
+		}
+		tflog.Debug(ctx, "Skipping workflow update", map[string]any{"id": state.ID.ValueString(), "readOnly": plan.ReadOnly.ValueBool()})
+
+		plan.ID = state.ID
+		plan.VersionId = state.VersionId
+		plan.CreatedAt = state.CreatedAt
+		plan.UpdatedAt = state.UpdatedAt
+		plan.ContentHash = state.ContentHash
+		plan.TriggerCount = state.TriggerCount
+		plan.CalledWorkflowIDs = state.CalledWorkflowIDs
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	current, err := client.GetWorkflow(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workflow", err.Error())
+		return
+	}
+
+	// Without nodes, the content is left as it currently is on the server
+	contentUnmanaged := workflowContentUnmanaged(ctx, req.Config)
+
+	// Never silently overwrite changes made since the last refresh
+	if !plan.Force.ValueBool() && !contentUnmanaged {
+		if detail := concurrentModificationDetail(state.VersionId.ValueString(), current); detail != "" {
+			resp.Diagnostics.AddError("Workflow was modified outside Terraform since last refresh", detail)
+			return
+		}
+	}
+
+	var workflow *n8n.Workflow
+	if diff.activationOnly() {
+		// The activation state has its own endpoints, so the content is not
+		// sent again only to activate or deactivate the workflow
+		tflog.Debug(ctx, "Skipping workflow content update", map[string]any{"id": state.ID.ValueString()})
+		workflow = current
+	} else {
+		workflow = r.writeWorkflowContent(ctx, client, plan, state, current, contentUnmanaged, &resp.Diagnostics)
+		if workflow == nil {
+			return
+		}
+	}
+
+	// Handle activation state change. The content is already written, so a
+	// failed change is reported once the workflow is saved in state, leaving
+	// only the activation to retry on the next apply.
+	var activationDiag diag.Diagnostic
+	if plan.Active.ValueBool() != state.Active.ValueBool() {
+		workflow, activationDiag = changeWorkflowActivation(ctx, client, workflow, plan)
+	}
+
+	workflow = readWorkflowAfterWrite(ctx, client, workflow)
+
+	// Check that n8n kept the workflow active once its triggers were registered
+	var activationErr error
+	if plan.VerifyActivation.ValueBool() && plan.Active.ValueBool() && activationDiag == nil {
+		workflow, activationErr = verifyWorkflowActivation(ctx, client, workflow)
+	}
+
+	// Wait for the webhooks of the active workflow to be served
+	var webhooksErr error
+	if plan.WaitForWebhooks.ValueBool() && workflow.Active && activationErr == nil {
+		webhooksErr = waitForWebhooks(ctx, client, workflow)
+	}
+
+	// Map response to state
+	if !workflowToState(workflow, contentUnmanaged, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if activationDiag != nil {
+		resp.Diagnostics.Append(activationDiag)
+	}
+	if activationErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("active"), "Workflow did not stay active", activationErr.Error())
+	}
+	if webhooksErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_for_webhooks"), "Webhooks not ready", webhooksErr.Error())
+	}
+}
+
+// workflowToState stores the computed attributes of a written workflow, and
+// its content when the content is unmanaged, in a resource model. It returns
+// false if an error was added to diagnostics.
+func workflowToState(workflow *n8n.Workflow, contentUnmanaged bool, model *workflowResourceModel, diagnostics *diag.Diagnostics) bool {
+	contentHash, err := workflowContentHash(workflow)
+	if err != nil {
+		diagnostics.AddError("Error hashing workflow content", err.Error())
+		return false
+	}
+
+	// Store the content kept from n8n
+	if contentUnmanaged {
+		if !workflowContentToState(workflow, model, diagnostics) {
+			return false
+		}
+	}
+
+	model.ID = types.StringValue(workflow.ID)
+	model.VersionId = types.StringValue(workflow.VersionId)
+	model.CreatedAt = types.StringValue(workflow.CreatedAt)
+	model.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+	model.ContentHash = types.StringValue(contentHash)
+	model.TriggerCount = types.Int64Value(int64(workflow.TriggerCount))
+	model.CalledWorkflowIDs = stringListValue(calledWorkflowIDs(workflow.Nodes))
+	model.Active = types.BoolValue(workflow.Active)
+	model.Settings = &settingsResourceModel{
+		SaveExecutionProgress:    types.BoolValue(workflow.Settings.GetSaveExecutionProgress()),
+		SaveManualExecutions:     types.BoolValue(workflow.Settings.GetSaveManualExecutions()),
+		SaveDataErrorExecution:   types.StringValue(workflow.Settings.GetSaveDataErrorExecution()),
+		SaveDataSuccessExecution: types.StringValue(workflow.Settings.GetSaveDataSuccessExecution()),
+		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.GetExecutionTimeout())),
+		ErrorWorkflow:            types.StringValue(workflow.Settings.GetErrorWorkflow()),
+		Timezone:                 types.StringValue(workflow.Settings.GetTimezone()),
+		ExecutionOrder:           types.StringValue(workflow.Settings.GetExecutionOrder()),
+	}
+	return true
+}
+
+// changeWorkflowActivation activates or deactivates a written workflow as
+// planned, waiting for the workflows of activate_after first. It returns the
+// workflow as it is after the change, which is the given one when the change
+// failed, along with the diagnostic of the failure, so that callers can save
+// the written workflow in state before reporting it.
+func changeWorkflowActivation(ctx context.Context, client *n8n.Client, workflow *n8n.Workflow, plan workflowResourceModel) (*n8n.Workflow, diag.Diagnostic) {
+	var changed *n8n.Workflow
+	var err error
+	if plan.Active.ValueBool() {
+		if err := waitForActivationDependencies(ctx, client, workflow.ID, plan.ActivateAfter); err != nil {
+			return workflow, diag.NewAttributeErrorDiagnostic(path.Root("activate_after"), "Workflow dependencies are not active", err.Error())
+		}
+		changed, err = client.ActivateWorkflow(workflow.ID)
+	} else {
+		changed, err = client.DeactivateWorkflow(workflow.ID)
+	}
+	if err != nil {
+		return workflow, diag.NewAttributeErrorDiagnostic(
+			path.Root("active"),
+			"Error changing workflow activation state",
+			fmt.Sprintf("%s\n\nThe workflow content was written and saved in state; only the activation is retried on the next apply.", workflowWriteError(err, workflow.Nodes)),
+		)
+	}
+	return changed, nil
+}
+
+// writeWorkflowContent sends the planned name, nodes, connections, settings
+// and extra fields of a workflow to n8n, which replaces them all, and returns
+// the updated workflow, or nil if an error was added to diagnostics. The
+// content currently on the server is kept when it is unmanaged.
+func (r *workflowResource) writeWorkflowContent(ctx context.Context, client *n8n.Client, plan, state workflowResourceModel, current *n8n.Workflow, contentUnmanaged bool, diagnostics *diag.Diagnostics) *n8n.Workflow {
+	var err error
+
+	nodesJSON := plan.Nodes.ValueString()
+	connectionsJSON := plan.Connections.ValueString()
+
+	// Keep the sticky notes as they currently are on the server
+	if plan.IgnoreStickyNotes.ValueBool() && !contentUnmanaged {
+		nodesJSON, err = preserveStickyNotes(nodesJSON, current.Nodes)
+		if err != nil {
+			diagnostics.AddError("Error applying ignore_sticky_notes", err.Error())
+			return nil
+		}
+	}
+
+	// Keep values matched by ignore_paths as they currently are on the server
+	if plan.IgnorePaths != nil && !contentUnmanaged {
+		nodesJSON, connectionsJSON, err = r.preserveIgnoredPaths(ctx, client, state.ID.ValueString(), plan.IgnorePaths, nodesJSON, connectionsJSON)
+		if err != nil {
+			diagnostics.AddError("Error applying ignore_paths", err.Error())
+			return nil
+		}
+	}
+
+	// Send the content back as it currently is on the server, with the node
+	// fields and connection types the provider does not model, when it is
+	// left to the n8n editor
+	nodes, connections := current.Nodes, current.Connections
+	if !contentUnmanaged {
+		// Parse nodes from JSON
+		nodes = nil
+		if err := json.Unmarshal([]byte(nodesJSON), &nodes); err != nil {
+			diagnostics.AddError("Invalid nodes JSON", err.Error())
+			return nil
+		}
+
+		// Parse connections from JSON
+		connections = nil
+		if err := json.Unmarshal([]byte(connectionsJSON), &connections); err != nil {
+			diagnostics.AddError("Invalid connections JSON", err.Error())
+			return nil
+		}
+
+		if err := overrideSubworkflows(nodes, subworkflowOverrides(ctx, plan.SubworkflowOverrides)); err != nil {
+			diagnostics.AddAttributeError(path.Root("subworkflow_overrides"), "Invalid sub-workflow override", err.Error())
+			return nil
+		}
+	}
+
 	// Build settings
-	settings := n8n.Settings{}
-	if plan.Settings != nil {
-		settings.SaveExecutionProgress = plan.Settings.SaveExecutionProgress.ValueBool()
-		settings.SaveManualExecutions = plan.Settings.SaveManualExecutions.ValueBool()
-		settings.SaveDataErrorExecution = plan.Settings.SaveDataErrorExecution.ValueString()
-		settings.SaveDataSuccessExecution = plan.Settings.SaveDataSuccessExecution.ValueString()
-		settings.ExecutionTimeout = int(plan.Settings.ExecutionTimeout.ValueInt64())
-		settings.ErrorWorkflow = plan.Settings.ErrorWorkflow.ValueString()
-		settings.Timezone = plan.Settings.Timezone.ValueString()
-		settings.ExecutionOrder = plan.Settings.ExecutionOrder.ValueString()
+	settings := workflowSettingsFromModel(n8n.Settings{}, plan.Settings)
+
+	// Keep settings the schema does not know about, as the update replaces them all
+	settings.Extra = current.Settings.Extra
+
+	extraFields, err := decodeExtraFields(plan.ExtraFields)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("extra_fields"), "Invalid extra_fields JSON", err.Error())
+		return nil
 	}
 
 	updateReq := &n8n.UpdateWorkflowRequest{
-		Name:        plan.Name.ValueString(),
+		Name:        r.workflowNames.apply(keepEquivalentName(plan.NormalizeName.ValueBool(), plan.Name.ValueString(), r.workflowNames.strip(current.Name))),
 		Nodes:       nodes,
 		Connections: connections,
 		Settings:    settings,
+		Extra:       extraFields,
 	}
 
 	tflog.Debug(ctx, "Updating workflow", map[string]any{"id": state.ID.ValueString()})
 
-	workflow, err := r.client.UpdateWorkflow(state.ID.ValueString(), updateReq)
+	workflow, err := client.UpdateWorkflow(state.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating workflow", err.Error())
+		diagnostics.AddError("Error updating workflow", workflowWriteError(err, updateReq.Nodes))
+		return nil
+	}
+	return workflow
+}
+
+// workflowContentUnmanaged reports whether the configuration omits the nodes
+// of the workflow, leaving its content to the n8n editor.
+func workflowContentUnmanaged(ctx context.Context, config tfsdk.Config) bool {
+	var nodes types.String
+	if diags := config.GetAttribute(ctx, path.Root("nodes"), &nodes); diags.HasError() {
+		return false
+	}
+	return nodes.IsNull()
+}
+
+// requireContentToCreate fails the plan of a new workflow without nodes, whose
+// content can only come from a workflow taken over by name.
+func (r *workflowResource) requireContentToCreate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var adopt types.Bool
+	if diags := req.Plan.GetAttribute(ctx, path.Root("adopt_existing_by_name"), &adopt); diags.HasError() || adopt.ValueBool() || !workflowContentUnmanaged(ctx, req.Config) {
 		return
 	}
 
-	// Handle activation state change
-	if plan.Active.ValueBool() != state.Active.ValueBool() {
-		if plan.Active.ValueBool() {
-			workflow, err = r.client.ActivateWorkflow(workflow.ID)
-		} else {
-			workflow, err = r.client.DeactivateWorkflow(workflow.ID)
-		}
-		if err != nil {
-			resp.Diagnostics.AddError("Error changing workflow activation state", err.Error())
-			return
-		}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("nodes"),
+		"Cannot create workflow without nodes",
+		"Only the metadata of an existing workflow can be managed without nodes and connections. Import the workflow, set adopt_existing_by_name, or set nodes and connections to create it.",
+	)
+}
+
+// workflowContentToState stores the nodes and connections of a workflow
+// read from n8n in a model, reporting whether they could be serialized.
+func workflowContentToState(workflow *n8n.Workflow, model *workflowResourceModel, diags *diag.Diagnostics) bool {
+	nodesJSON, err := workflowCanonicalJSON(sortNodes(workflow.Nodes))
+	if err != nil {
+		diags.AddError("Error serializing nodes", err.Error())
+		return false
+	}
+	connectionsJSON, err := workflowCanonicalJSON(workflow.Connections)
+	if err != nil {
+		diags.AddError("Error serializing connections", err.Error())
+		return false
 	}
 
-	// Map response to state
-	plan.ID = types.StringValue(workflow.ID)
-	plan.VersionId = types.StringValue(workflow.VersionId)
-	plan.CreatedAt = types.StringValue(workflow.CreatedAt)
-	plan.UpdatedAt = types.StringValue(workflow.UpdatedAt)
-	plan.Active = types.BoolValue(workflow.Active)
-	plan.Settings = &settingsResourceModel{
-		SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-		SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-		SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-		SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-		ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-		Timezone:                 types.StringValue(workflow.Settings.Timezone),
-		ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
+	model.Nodes = types.StringValue(nodesJSON)
+	model.Connections = types.StringValue(connectionsJSON)
+	return true
+}
+
+// clientFor returns the client for the endpoint of a workflow, falling back to
+// the provider client when the workflow does not override it. The client
+// records its calls in the metrics of the operation tracked in ctx.
+func (r *workflowResource) clientFor(ctx context.Context, endpoint *endpointResourceModel) (*n8n.Client, error) {
+	if endpoint == nil {
+		return meteredClient(ctx, r.client), nil
 	}
+	client, err := endpointClients.get(endpoint.Host.ValueString(), endpoint.Token.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return meteredClient(ctx, client), nil
+}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+// executionOrderChangeDetail explains the effect of moving a workflow from the
+// legacy v0 execution order to v1, which happens when the configuration of a
+// workflow created before n8n 1.0 sets settings.execution_order to v1. It
+// returns an empty string for any other change.
+func executionOrderChangeDetail(stateOrder, planOrder types.String) string {
+	if stateOrder.ValueString() != "v0" || planOrder.IsUnknown() || planOrder.ValueString() != "v1" {
+		return ""
+	}
+
+	return "The workflow uses the legacy v0 execution order and the configuration sets v1, the default. " +
+		"With v0, the first node of each branch runs, then the second node of each branch, and so on. " +
+		"With v1, each branch runs to completion before the next one starts, ordered by canvas position from top to bottom then left to right. " +
+		"Workflows relying on the v0 order may behave differently. Set settings.execution_order to \"v0\" to keep the current behavior."
+}
+
+// warnAboutDuplicateName warns when a workflow about to be created has the
+// same name as an existing one, since n8n allows duplicate names and both
+// would then run.
+func (r *workflowResource) warnAboutDuplicateName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Computed attributes are always unknown on create, only skip the check
+	// when the plan cannot be decoded because of unknown nested objects
+	var plan workflowResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() || plan.Name.IsUnknown() || plan.AdoptExistingByName.ValueBool() || plan.ReadOnly.ValueBool() {
+		return
+	}
+	if plan.Endpoint != nil && (plan.Endpoint.Host.IsUnknown() || plan.Endpoint.Token.IsUnknown()) {
+		return
+	}
+
+	client, err := r.clientFor(ctx, plan.Endpoint)
+	if err != nil || client == nil {
+		return
+	}
+
+	name := r.workflowNames.apply(plan.Name.ValueString())
+	existing, err := workflowsNamed(client, name)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check for duplicate workflow names", map[string]any{"error": err.Error()})
+		return
+	}
+	if len(existing) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("name"),
+		"Workflow name already in use",
+		fmt.Sprintf("A workflow named %q already exists in n8n (%s). Applying creates a duplicate. Import the existing workflow or set adopt_existing_by_name to take it over.", name, strings.Join(workflowIDs(existing), ", ")),
+	)
+}
+
+// workflowCanonicalJSON serializes workflow content read from the API in the
+// canonical form stored in state, see NormalizeJSON.
+func workflowCanonicalJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	// Decode from the marshaled bytes directly rather than through
+	// NormalizeJSON, which would copy them into a string first
+	obj, err := decodeJSONFrom(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	return canonicalJSON(obj)
+}
+
+// preserveIgnoredPaths overlays the values matched by the ignore_paths pointers
+// with the values currently stored on the server, so that an update never
+// overwrites values that are managed outside Terraform.
+func (r *workflowResource) preserveIgnoredPaths(ctx context.Context, client *n8n.Client, workflowID string, ignorePaths *ignorePathsResourceModel, nodesJSON, connectionsJSON string) (string, string, error) {
+	var nodePointers, connectionPointers []string
+	if diags := ignorePaths.Nodes.ElementsAs(ctx, &nodePointers, false); diags.HasError() {
+		return "", "", fmt.Errorf("unable to read ignore_paths.nodes")
+	}
+	if diags := ignorePaths.Connections.ElementsAs(ctx, &connectionPointers, false); diags.HasError() {
+		return "", "", fmt.Errorf("unable to read ignore_paths.connections")
+	}
+	if len(nodePointers) == 0 && len(connectionPointers) == 0 {
+		return nodesJSON, connectionsJSON, nil
+	}
+
+	current, err := client.GetWorkflow(workflowID)
+	if err != nil {
+		return "", "", err
+	}
+
+	currentNodes, err := json.Marshal(current.Nodes)
+	if err != nil {
+		return "", "", err
+	}
+	currentConnections, err := json.Marshal(current.Connections)
+	if err != nil {
+		return "", "", err
+	}
+
+	nodesJSON, err = overlayJSONPointers(nodesJSON, string(currentNodes), nodePointers)
+	if err != nil {
+		return "", "", fmt.Errorf("nodes: %w", err)
+	}
+	connectionsJSON, err = overlayJSONPointers(connectionsJSON, string(currentConnections), connectionPointers)
+	if err != nil {
+		return "", "", fmt.Errorf("connections: %w", err)
+	}
+
+	return nodesJSON, connectionsJSON, nil
 }
 
 func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	ctx, logAPICalls := trackAPICalls(ctx, "delete")
+	defer logAPICalls()
+
 	var state workflowResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -427,9 +1272,34 @@ func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Read-only workflows are only removed from state
+	if state.ReadOnly.ValueBool() {
+		tflog.Debug(ctx, "Removing read-only workflow from state without deleting it", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting workflow", map[string]any{"id": state.ID.ValueString()})
 
-	_, err := r.client.DeleteWorkflow(state.ID.ValueString())
+	client, err := r.clientFor(ctx, state.Endpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create n8n API Client", err.Error())
+		return
+	}
+
+	// Check the tags again, as they may have been added since the plan
+	if len(r.protectedTags) > 0 {
+		workflow, err := client.GetWorkflow(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading workflow", err.Error())
+			return
+		}
+		if tags := protectedTagsOf(workflow.Tags, r.protectedTags); len(tags) > 0 {
+			resp.Diagnostics.AddError("Protected workflow", protectedWorkflowDetail(workflow, tags))
+			return
+		}
+	}
+
+	_, err = client.DeleteWorkflow(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting workflow", err.Error())
 		return
@@ -440,83 +1310,179 @@ func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// ModifyPlan implements resource-level plan modification to prevent unnecessary updates.
-// When only computed fields (updated_at, version_id) differ, we preserve state values
-// to avoid triggering an update that would only change timestamps.
-func (r *workflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Skip during create (no state) or destroy (no plan)
-	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+// ValidateConfig rejects configurations setting the error workflow both by
+// name and by ID.
+func (r *workflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name, id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("error_workflow_name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, errorWorkflowSettingPath, &id)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var plan, state workflowResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if !name.IsNull() && !id.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("error_workflow_name"),
+			"Conflicting error workflow settings",
+			"Set either error_workflow_name or settings.error_workflow, not both.",
+		)
+	}
+
+	var nodes, connections types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nodes"), &nodes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connections"), &connections)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check if any content fields actually changed
-	contentChanged := false
+	if nodes.IsNull() != connections.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connections"),
+			"Incomplete workflow content",
+			"Set both nodes and connections to manage the content of the workflow, or neither to leave it to the n8n editor.",
+		)
+	}
+}
+
+// ModifyPlan implements resource-level plan modification to prevent unnecessary updates.
+// When only computed fields (updated_at, version_id) differ, we preserve state values
+// to avoid triggering an update that would only change timestamps.
+func (r *workflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, logAPICalls := trackAPICalls(ctx, "plan")
+	defer logAPICalls()
 
-	// Compare name
-	if !plan.Name.Equal(state.Name) {
-		contentChanged = true
+	// Nodes referencing credentials created in the same run are unknown, which
+	// leaves nothing to check or compare. Terraform versions supporting deferred
+	// actions plan the workflow in a later round, once the credentials exist.
+	if !req.Plan.Raw.IsNull() && req.ClientCapabilities.DeferralAllowed && !workflowContentUnmanaged(ctx, req.Config) {
+		var plan workflowResourceModel
+		if diags := req.Plan.Get(ctx, &plan); !diags.HasError() && workflowContentUnknown(plan) && !plan.ReadOnly.ValueBool() {
+			tflog.Debug(ctx, "Deferring workflow with unknown content", map[string]any{"name": plan.Name.ValueString()})
+			resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonResourceConfigUnknown}
+			return
+		}
 	}
 
-	// Compare active
-	if !plan.Active.Equal(state.Active) {
-		contentChanged = true
+	// Resolve the error workflow before settings are compared
+	if !req.Plan.Raw.IsNull() {
+		r.resolveErrorWorkflowName(ctx, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		warnAboutInvalidSchedules(ctx, req, resp)
+		warnAboutInlineSecrets(ctx, req, resp)
+		r.warnAboutWebhookCollisions(ctx, req, resp)
+		r.lintPlannedWorkflow(ctx, req, resp)
+		r.refuseDisallowedNodeTypes(ctx, resp)
+		r.refuseDisallowedCredentialTypes(ctx, resp)
+		checkSubworkflowOverrides(ctx, resp)
+		r.checkWorkflowLimits(ctx, req, resp)
+		r.validateOnPlan(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Warn about duplicates during create (no state)
+	if req.State.Raw.IsNull() {
+		r.requireContentToCreate(ctx, req, resp)
+		r.warnAboutDuplicateName(ctx, req, resp)
+		return
 	}
 
-	// Compare nodes (using semantic equality)
-	if !plan.Nodes.IsUnknown() && !state.Nodes.IsUnknown() {
-		if !jsonSemanticEqual(plan.Nodes.ValueString(), state.Nodes.ValueString()) {
-			contentChanged = true
+	// Workflows carrying a protected tag are never deleted
+	if req.Plan.Raw.IsNull() || len(resp.RequiresReplace) > 0 {
+		r.refuseProtectedDestroy(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	// Compare connections (using semantic equality)
-	if !plan.Connections.IsUnknown() && !state.Connections.IsUnknown() {
-		if !jsonSemanticEqual(plan.Connections.ValueString(), state.Connections.ValueString()) {
-			contentChanged = true
+	// Destroying a read-only workflow leaves it in n8n
+	if req.Plan.Raw.IsNull() {
+		var readOnly types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("read_only"), &readOnly)...)
+		if readOnly.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				"Read-only workflow will not be deleted",
+				"The workflow has read_only set and will only be removed from Terraform state. It remains in n8n.",
+			)
 		}
+		return
 	}
 
-	// Compare settings
+	var plan, state workflowResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if any content fields actually changed
+	nodesOpts := jsonSemanticOptionsFromConfig(ctx, req.Config, "nodes")
+	connectionsOpts := jsonSemanticOptionsFromConfig(ctx, req.Config, "connections")
+	diff := compareWorkflowContent(plan, state, nodesOpts, connectionsOpts)
+
 	if plan.Settings != nil && state.Settings != nil {
-		if !plan.Settings.SaveExecutionProgress.Equal(state.Settings.SaveExecutionProgress) ||
-			!plan.Settings.SaveManualExecutions.Equal(state.Settings.SaveManualExecutions) ||
-			!plan.Settings.SaveDataErrorExecution.Equal(state.Settings.SaveDataErrorExecution) ||
-			!plan.Settings.SaveDataSuccessExecution.Equal(state.Settings.SaveDataSuccessExecution) ||
-			!plan.Settings.ExecutionTimeout.Equal(state.Settings.ExecutionTimeout) ||
-			!plan.Settings.ErrorWorkflow.Equal(state.Settings.ErrorWorkflow) ||
-			!plan.Settings.Timezone.Equal(state.Settings.Timezone) ||
-			!plan.Settings.ExecutionOrder.Equal(state.Settings.ExecutionOrder) {
-			contentChanged = true
+		if detail := executionOrderChangeDetail(state.Settings.ExecutionOrder, plan.Settings.ExecutionOrder); detail != "" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("settings").AtName("execution_order"), "Workflow execution order will change", detail)
 		}
-	} else if (plan.Settings == nil) != (state.Settings == nil) {
-		contentChanged = true
 	}
 
 	tflog.Debug(ctx, "ModifyPlan content comparison", map[string]any{
-		"contentChanged": contentChanged,
+		"contentChanged": diff.changed,
+		"contentUnknown": diff.unknown,
 		"workflowId":     state.ID.ValueString(),
 	})
 
-	// If no content changed, preserve computed field values from state
-	// This prevents unnecessary updates that would only change timestamps
-	if !contentChanged {
-		// Preserve version_id from state
+	// Read-only workflows report drift but are never written, so their
+	// computed fields keep the values read from n8n
+	if plan.ReadOnly.ValueBool() {
+		if diff.changed {
+			resp.Diagnostics.AddWarning(
+				"Read-only workflow has drifted",
+				fmt.Sprintf("Workflow %s differs from the configuration in: %s. The changes will not be written to n8n because read_only is set.", state.ID.ValueString(), strings.Join(diff.changedFields, ", ")),
+			)
+		}
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_id"), state.VersionId)...)
-		// Preserve updated_at from state
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), state.UpdatedAt)...)
-		// Also preserve nodes and connections with state values to ensure no diff
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), state.ContentHash)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("trigger_count"), state.TriggerCount)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("called_workflow_ids"), state.CalledWorkflowIDs)...)
+	}
+
+	if diff.changed {
+		if !plan.ReadOnly.ValueBool() {
+			warnAboutRename(plan, state, resp)
+			warnAboutNodeRenames(plan, state, resp)
+		}
+		return
+	}
+
+	// Known nodes and connections are semantically equal to state, preserve the
+	// state values to ensure no diff
+	if !plan.Nodes.IsUnknown() {
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nodes"), state.Nodes)...)
+	}
+	if !plan.Connections.IsUnknown() {
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connections"), state.Connections)...)
+	}
 
-		tflog.Debug(ctx, "No content changes detected, preserving state values for computed fields", map[string]any{
-			"workflowId": state.ID.ValueString(),
-		})
+	// Values that are still unknown may turn out to differ at apply time, in
+	// which case the workflow is updated and its computed fields change
+	if diff.unknown {
+		return
 	}
+
+	// If no content changed, preserve computed field values from state
+	// This prevents unnecessary updates that would only change timestamps
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_id"), state.VersionId)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), state.UpdatedAt)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), state.ContentHash)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("trigger_count"), state.TriggerCount)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("called_workflow_ids"), state.CalledWorkflowIDs)...)
+
+	tflog.Debug(ctx, "No content changes detected, preserving state values for computed fields", map[string]any{
+		"workflowId": state.ID.ValueString(),
+	})
 }
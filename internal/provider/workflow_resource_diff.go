@@ -0,0 +1,110 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowContentDiff describes how the planned content of a workflow relates
+// to the content recorded in state.
+type workflowContentDiff struct {
+	// changed is set when a known planned value differs from state.
+	changed bool
+
+	// unknown is set when a planned value is not known yet, for example when
+	// settings.error_workflow references a workflow that is being created.
+	unknown bool
+
+	// changedFields lists the attributes whose known planned value differs from state.
+	changedFields []string
+}
+
+// compareWorkflowContent compares the content fields of a planned workflow
+// with its state. Unknown planned values never count as changes; they are
+// reported separately so callers can decide whether computed values such as
+// version_id can be kept.
+func compareWorkflowContent(plan, state workflowResourceModel, nodesOpts, connectionsOpts jsonSemanticOptions) workflowContentDiff {
+	var diff workflowContentDiff
+
+	// Equivalent names are never written with normalize_name set
+	if !plan.NormalizeName.ValueBool() || plan.Name.IsUnknown() || !equivalentNames(plan.Name.ValueString(), state.Name.ValueString()) {
+		diff.compareValue("name", plan.Name, state.Name)
+	}
+	diff.compareValue("active", plan.Active, state.Active)
+	diff.compareJSON("nodes", plan.Nodes, state.Nodes, nodesOpts)
+	diff.compareJSON("connections", plan.Connections, state.Connections, connectionsOpts)
+	diff.compareSettings(plan.Settings, state.Settings)
+	diff.compareJSON("extra_fields", plan.ExtraFields, state.ExtraFields, jsonSemanticOptions{})
+	diff.compareValue("subworkflow_overrides", plan.SubworkflowOverrides, state.SubworkflowOverrides)
+
+	return diff
+}
+
+// activationOnly reports whether the activation state is the only change,
+// which is applied with the activation endpoints without sending the content.
+// The n8n API has no partial update, so any other change sends the whole
+// workflow, as does a value that is still unknown.
+func (d workflowContentDiff) activationOnly() bool {
+	return !d.unknown && len(d.changedFields) == 1 && d.changedFields[0] == "active"
+}
+
+// workflowContentUnknown reports whether the planned nodes or connections of a
+// workflow are unknown, typically because they reference the ID of a
+// credential or workflow that is not created yet.
+func workflowContentUnknown(plan workflowResourceModel) bool {
+	return plan.Nodes.IsUnknown() || plan.Connections.IsUnknown()
+}
+
+// compareValue records the difference between a planned and a state value.
+func (d *workflowContentDiff) compareValue(field string, plan, state attr.Value) {
+	if plan.IsUnknown() {
+		d.unknown = true
+		return
+	}
+	if !plan.Equal(state) {
+		d.markChanged(field)
+	}
+}
+
+// markChanged records that the given field changed.
+func (d *workflowContentDiff) markChanged(field string) {
+	d.changed = true
+	d.changedFields = append(d.changedFields, field)
+}
+
+// compareJSON records the difference between two JSON strings, using a string
+// comparison first and falling back to semantic equality.
+func (d *workflowContentDiff) compareJSON(field string, plan, state types.String, opts jsonSemanticOptions) {
+	if plan.IsUnknown() {
+		d.unknown = true
+		return
+	}
+	if plan.Equal(state) {
+		return
+	}
+	if plan.IsNull() || state.IsNull() || state.IsUnknown() || !jsonSemanticEqualWithOptions(plan.ValueString(), state.ValueString(), opts) {
+		d.markChanged(field)
+	}
+}
+
+// compareSettings records the differences between planned and state settings.
+func (d *workflowContentDiff) compareSettings(plan, state *settingsResourceModel) {
+	if plan == nil || state == nil {
+		if plan != state {
+			d.markChanged("settings")
+		}
+		return
+	}
+
+	d.compareValue("settings.save_execution_progress", plan.SaveExecutionProgress, state.SaveExecutionProgress)
+	d.compareValue("settings.save_manual_executions", plan.SaveManualExecutions, state.SaveManualExecutions)
+	d.compareValue("settings.save_data_error_execution", plan.SaveDataErrorExecution, state.SaveDataErrorExecution)
+	d.compareValue("settings.save_data_success_execution", plan.SaveDataSuccessExecution, state.SaveDataSuccessExecution)
+	d.compareValue("settings.execution_timeout", plan.ExecutionTimeout, state.ExecutionTimeout)
+	d.compareValue("settings.error_workflow", plan.ErrorWorkflow, state.ErrorWorkflow)
+	d.compareValue("settings.timezone", plan.Timezone, state.Timezone)
+	d.compareValue("settings.execution_order", plan.ExecutionOrder, state.ExecutionOrder)
+}
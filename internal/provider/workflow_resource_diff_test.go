@@ -0,0 +1,189 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testWorkflowResourceModel() workflowResourceModel {
+	return workflowResourceModel{
+		ID:          types.StringValue("1"),
+		Name:        types.StringValue("Workflow"),
+		Active:      types.BoolValue(false),
+		Nodes:       types.StringValue(`[{"id":"1","name":"Start"}]`),
+		Connections: types.StringValue(`{}`),
+		Settings: &settingsResourceModel{
+			SaveExecutionProgress:    types.BoolValue(true),
+			SaveManualExecutions:     types.BoolValue(true),
+			SaveDataErrorExecution:   types.StringValue("all"),
+			SaveDataSuccessExecution: types.StringValue("all"),
+			ExecutionTimeout:         types.Int64Value(3600),
+			ErrorWorkflow:            types.StringValue("error-workflow"),
+			Timezone:                 types.StringValue("America/New_York"),
+			ExecutionOrder:           types.StringValue("v1"),
+		},
+		CalledWorkflowIDs:    types.ListNull(types.StringType),
+		ActivateAfter:        types.ListNull(types.StringType),
+		SubworkflowOverrides: types.MapNull(types.StringType),
+	}
+}
+
+func TestCompareWorkflowContent(t *testing.T) {
+	tests := []struct {
+		name            string
+		modify          func(plan *workflowResourceModel)
+		expectedChanged bool
+		expectedUnknown bool
+		expectedFields  []string
+	}{
+		{
+			name:   "identical",
+			modify: func(plan *workflowResourceModel) {},
+		},
+		{
+			name: "nodes formatted differently",
+			modify: func(plan *workflowResourceModel) {
+				plan.Nodes = types.StringValue(`[ { "name": "Start", "id": "1" } ]`)
+			},
+		},
+		{
+			name: "nodes changed",
+			modify: func(plan *workflowResourceModel) {
+				plan.Nodes = types.StringValue(`[{"id":"1","name":"Begin"}]`)
+			},
+			expectedChanged: true,
+			expectedFields:  []string{"nodes"},
+		},
+		{
+			name: "name changed",
+			modify: func(plan *workflowResourceModel) {
+				plan.Name = types.StringValue("Renamed")
+			},
+			expectedChanged: true,
+			expectedFields:  []string{"name"},
+		},
+		{
+			name: "name case changed",
+			modify: func(plan *workflowResourceModel) {
+				plan.Name = types.StringValue("WORKFLOW ")
+			},
+			expectedChanged: true,
+			expectedFields:  []string{"name"},
+		},
+		{
+			name: "name case changed with normalize_name",
+			modify: func(plan *workflowResourceModel) {
+				plan.Name = types.StringValue("WORKFLOW ")
+				plan.NormalizeName = types.BoolValue(true)
+			},
+		},
+		{
+			name: "name changed with normalize_name",
+			modify: func(plan *workflowResourceModel) {
+				plan.Name = types.StringValue("Renamed")
+				plan.NormalizeName = types.BoolValue(true)
+			},
+			expectedChanged: true,
+			expectedFields:  []string{"name"},
+		},
+		{
+			name: "known setting changed",
+			modify: func(plan *workflowResourceModel) {
+				plan.Settings.Timezone = types.StringValue("UTC")
+			},
+			expectedChanged: true,
+			expectedFields:  []string{"settings.timezone"},
+		},
+		{
+			name: "unknown setting",
+			modify: func(plan *workflowResourceModel) {
+				plan.Settings.ErrorWorkflow = types.StringUnknown()
+			},
+			expectedUnknown: true,
+		},
+		{
+			name: "unknown setting with known change",
+			modify: func(plan *workflowResourceModel) {
+				plan.Settings.ErrorWorkflow = types.StringUnknown()
+				plan.Settings.ExecutionTimeout = types.Int64Value(60)
+			},
+			expectedChanged: true,
+			expectedUnknown: true,
+			expectedFields:  []string{"settings.execution_timeout"},
+		},
+		{
+			name: "unknown nodes",
+			modify: func(plan *workflowResourceModel) {
+				plan.Nodes = types.StringUnknown()
+			},
+			expectedUnknown: true,
+		},
+		{
+			name: "settings removed",
+			modify: func(plan *workflowResourceModel) {
+				plan.Settings = nil
+			},
+			expectedChanged: true,
+			expectedFields:  []string{"settings"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testWorkflowResourceModel()
+			plan := testWorkflowResourceModel()
+			tt.modify(&plan)
+
+			diff := compareWorkflowContent(plan, state, jsonSemanticOptions{}, jsonSemanticOptions{})
+
+			assert.Equal(t, tt.expectedChanged, diff.changed, "changed")
+			assert.Equal(t, tt.expectedUnknown, diff.unknown, "unknown")
+			assert.Equal(t, tt.expectedFields, diff.changedFields, "changedFields")
+		})
+	}
+}
+
+func TestExecutionOrderChangeDetail(t *testing.T) {
+	assert.Contains(t, executionOrderChangeDetail(types.StringValue("v0"), types.StringValue("v1")), "legacy v0 execution order")
+	assert.Empty(t, executionOrderChangeDetail(types.StringValue("v0"), types.StringValue("v0")))
+	assert.Empty(t, executionOrderChangeDetail(types.StringValue("v1"), types.StringValue("v0")))
+	assert.Empty(t, executionOrderChangeDetail(types.StringValue("v0"), types.StringUnknown()))
+}
+
+func TestWorkflowContentUnknown(t *testing.T) {
+	plan := testWorkflowResourceModel()
+	assert.False(t, workflowContentUnknown(plan))
+
+	plan.Nodes = types.StringUnknown()
+	assert.True(t, workflowContentUnknown(plan), "nodes referencing a credential not created yet")
+
+	plan = testWorkflowResourceModel()
+	plan.Connections = types.StringUnknown()
+	assert.True(t, workflowContentUnknown(plan))
+
+	plan = testWorkflowResourceModel()
+	plan.Settings.ErrorWorkflow = types.StringUnknown()
+	assert.False(t, workflowContentUnknown(plan), "unknown settings are resolved at apply time")
+}
+
+func TestWorkflowContentDiffActivationOnly(t *testing.T) {
+	state := testWorkflowResourceModel()
+
+	plan := testWorkflowResourceModel()
+	plan.Active = types.BoolValue(!state.Active.ValueBool())
+	assert.True(t, compareWorkflowContent(plan, state, jsonSemanticOptions{}, jsonSemanticOptions{}).activationOnly())
+
+	plan.Settings.ErrorWorkflow = types.StringUnknown()
+	assert.False(t, compareWorkflowContent(plan, state, jsonSemanticOptions{}, jsonSemanticOptions{}).activationOnly(), "unknown values may hold other changes")
+
+	plan.Settings.ErrorWorkflow = state.Settings.ErrorWorkflow
+	plan.Name = types.StringValue("Renamed")
+	assert.False(t, compareWorkflowContent(plan, state, jsonSemanticOptions{}, jsonSemanticOptions{}).activationOnly(), "the content is sent along with other changes")
+
+	assert.False(t, compareWorkflowContent(state, state, jsonSemanticOptions{}, jsonSemanticOptions{}).activationOnly())
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorkflowResourcePlan returns a plan of the current n8n_workflow schema
+// holding the given model.
+func newWorkflowResourcePlan(ctx context.Context, t *testing.T, model workflowResourceModel) tfsdk.Plan {
+	t.Helper()
+
+	state := newWorkflowResourceState(ctx, t)
+	require.False(t, state.Set(ctx, &model).HasError())
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestWarnAboutDuplicateNameOnCreate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [{"id": "wf1", "name": "Orders"}]}`))
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := n8n.NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+	r := &workflowResource{client: client}
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name     string
+		warnings int
+	}{
+		{name: "Orders", warnings: 1},
+		{name: "Invoices", warnings: 0},
+	} {
+		// Computed attributes are unknown on create
+		model := testWorkflowResourceModel()
+		model.Name = types.StringValue(tt.name)
+		model.ID = types.StringUnknown()
+		model.VersionId = types.StringUnknown()
+
+		plan := newWorkflowResourcePlan(ctx, t, model)
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.warnAboutDuplicateName(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+
+		require.False(t, resp.Diagnostics.HasError())
+		require.Equal(t, tt.warnings, resp.Diagnostics.WarningsCount(), tt.name)
+		if tt.warnings > 0 {
+			assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "(wf1)")
+		}
+	}
+}
+
+func TestModifyPlanFormattingOnlyKeepsComputedValues(t *testing.T) {
+	ctx := context.Background()
+
+	state := testWorkflowResourceModel()
+	state.VersionId = types.StringValue("v1")
+	state.UpdatedAt = types.StringValue("2024-01-01T00:00:00.000Z")
+	state.ContentHash = types.StringValue("hash")
+	state.TriggerCount = types.Int64Value(1)
+	state.CalledWorkflowIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("wf2")})
+
+	// Only the formatting of the nodes differs, computed attributes are unknown
+	config := state
+	config.Nodes = types.StringValue("[\n  {\"id\": \"1\", \"name\": \"Start\"}\n]")
+	plan := config
+	plan.VersionId = types.StringUnknown()
+	plan.UpdatedAt = types.StringUnknown()
+	plan.ContentHash = types.StringUnknown()
+	plan.TriggerCount = types.Int64Unknown()
+	plan.CalledWorkflowIDs = types.ListUnknown(types.StringType)
+
+	priorState := newWorkflowResourceState(ctx, t)
+	require.False(t, priorState.Set(ctx, &state).HasError())
+	req := resource.ModifyPlanRequest{
+		Config: newWorkflowResourceConfig(ctx, t, config),
+		Plan:   newWorkflowResourcePlan(ctx, t, plan),
+		State:  priorState,
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	(&workflowResource{}).ModifyPlan(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var planned workflowResourceModel
+	require.False(t, resp.Plan.Get(ctx, &planned).HasError())
+	assert.Equal(t, state.Nodes, planned.Nodes)
+	assert.Equal(t, state.VersionId, planned.VersionId)
+	assert.Equal(t, state.UpdatedAt, planned.UpdatedAt)
+	assert.Equal(t, state.ContentHash, planned.ContentHash)
+	assert.Equal(t, state.TriggerCount, planned.TriggerCount)
+	assert.Equal(t, state.CalledWorkflowIDs, planned.CalledWorkflowIDs)
+}
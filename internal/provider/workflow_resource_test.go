@@ -0,0 +1,158 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorkflowResourceConfig returns a configuration holding the given model.
+func newWorkflowResourceConfig(ctx context.Context, t *testing.T, model workflowResourceModel) tfsdk.Config {
+	t.Helper()
+
+	state := newWorkflowResourceState(ctx, t)
+	require.False(t, state.Set(ctx, model).HasError())
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestWorkflowResourceValidateConfigContent(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		nodes       types.String
+		connections types.String
+		unmanaged   bool
+		expectError bool
+	}{
+		{"content managed", types.StringValue(`[]`), types.StringValue(`{}`), false, false},
+		{"content left to the editor", types.StringNull(), types.StringNull(), true, false},
+		{"nodes without connections", types.StringValue(`[]`), types.StringNull(), false, true},
+		{"connections without nodes", types.StringNull(), types.StringValue(`{}`), true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := testWorkflowResourceModel()
+			model.Nodes = tt.nodes
+			model.Connections = tt.connections
+			config := newWorkflowResourceConfig(ctx, t, model)
+
+			var resp resource.ValidateConfigResponse
+			(&workflowResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tt.unmanaged, workflowContentUnmanaged(ctx, config))
+		})
+	}
+}
+
+func TestChangeWorkflowActivation(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("POST /api/v1/workflows/wf1/activate", http.StatusOK, `{"id": "wf1", "name": "Orders", "active": true}`)
+	server.Respond("POST /api/v1/workflows/wf2/activate", http.StatusBadRequest, `{"message": "Workflow has no node to start the workflow"}`)
+
+	plan := testWorkflowResourceModel()
+	plan.Active = types.BoolValue(true)
+
+	activated, diagnostic := changeWorkflowActivation(context.Background(), server.Client(), &n8n.Workflow{ID: "wf1", Name: "Orders"}, plan)
+	assert.Nil(t, diagnostic)
+	assert.True(t, activated.Active)
+
+	// A failed activation returns the written workflow, to be saved in state
+	written := &n8n.Workflow{ID: "wf2", Name: "Draft", VersionId: "v2"}
+	workflow, diagnostic := changeWorkflowActivation(context.Background(), server.Client(), written, plan)
+	assert.Same(t, written, workflow)
+	require.NotNil(t, diagnostic)
+	assert.Equal(t, "Error changing workflow activation state", diagnostic.Summary())
+	assert.Contains(t, diagnostic.Detail(), "no node to start the workflow")
+	assert.Equal(t, path.Root("active"), diagnostic.(diag.DiagnosticWithPath).Path())
+}
+
+func TestWorkflowToState(t *testing.T) {
+	workflow := &n8n.Workflow{
+		ID:        "wf1",
+		Name:      "Orders",
+		VersionId: "v1",
+		CreatedAt: "2025-01-01T00:00:00.000Z",
+		UpdatedAt: "2025-01-02T00:00:00.000Z",
+		Nodes: []n8n.Node{
+			{ID: "1", Name: "Start", Type: "n8n-nodes-base.manualTrigger"},
+			{ID: "2", Name: "Enrich", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "sub"}},
+		},
+		Settings: n8n.Settings{Timezone: n8n.Ptr("UTC"), ExecutionTimeout: n8n.Ptr(-1)},
+	}
+
+	// A workflow created inactive is saved as such before its activation
+	model := testWorkflowResourceModel()
+	model.Active = types.BoolValue(true)
+	model.ID = types.StringUnknown()
+	model.ContentHash = types.StringUnknown()
+
+	var diags diag.Diagnostics
+	require.True(t, workflowToState(workflow, false, &model, &diags))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "wf1", model.ID.ValueString())
+	assert.Equal(t, "v1", model.VersionId.ValueString())
+	assert.False(t, model.Active.ValueBool())
+	assert.NotEmpty(t, model.ContentHash.ValueString())
+	assert.Equal(t, stringListValue([]string{"sub"}), model.CalledWorkflowIDs)
+	assert.Equal(t, "UTC", model.Settings.Timezone.ValueString())
+	assert.Equal(t, int64(-1), model.Settings.ExecutionTimeout.ValueInt64())
+	assert.Equal(t, "", model.Settings.ExecutionOrder.ValueString(), "unset settings are stored as zero values")
+}
+
+func TestUpdateUnmanagedContentKeepsEditorContent(t *testing.T) {
+	ctx := context.Background()
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders", "versionId": "v1", "nodes": `+editorNodes+`, "connections": `+editorConnections+`}`)
+	server.Respond("PUT /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders v2", "versionId": "v1", "nodes": `+editorNodes+`, "connections": `+editorConnections+`}`)
+	r := &workflowResource{client: server.Client()}
+
+	state := testWorkflowResourceModel()
+	state.ID = types.StringValue("wf1")
+	state.Name = types.StringValue("Orders")
+	state.VersionId = types.StringValue("v1")
+	state.Nodes = types.StringValue(editorNodes)
+	state.Connections = types.StringValue(editorConnections)
+
+	// Only the name is managed, the content is left to the n8n editor
+	config := state
+	config.Name = types.StringValue("Orders v2")
+	config.Nodes = types.StringNull()
+	config.Connections = types.StringNull()
+	plan := state
+	plan.Name = types.StringValue("Orders v2")
+
+	priorState := newWorkflowResourceState(ctx, t)
+	require.False(t, priorState.Set(ctx, &state).HasError())
+	req := resource.UpdateRequest{
+		Config: newWorkflowResourceConfig(ctx, t, config),
+		Plan:   newWorkflowResourcePlan(ctx, t, plan),
+		State:  priorState,
+	}
+	resp := resource.UpdateResponse{State: newWorkflowResourceState(ctx, t)}
+	r.Update(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	requests := server.Requests("PUT /api/v1/workflows/wf1")
+	require.Len(t, requests, 1)
+	assertEditorContentKept(t, requests[0])
+
+	var saved workflowResourceModel
+	require.False(t, resp.State.Get(ctx, &saved).HasError())
+	assert.JSONEq(t, editorNodes, saved.Nodes.ValueString())
+	assert.JSONEq(t, editorConnections, saved.Connections.ValueString())
+}
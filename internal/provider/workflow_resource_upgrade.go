@@ -0,0 +1,119 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowResourceSchemaVersion is the current version of the n8n_workflow
+// resource schema. Bump it together with a new entry in UpgradeState whenever
+// attributes are renamed, removed or restructured.
+const workflowResourceSchemaVersion int64 = 1
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithUpgradeState = &workflowResource{}
+
+// workflowResourceModelV0 maps the version 0 resource schema data.
+type workflowResourceModelV0 struct {
+	ID          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Active      types.Bool             `tfsdk:"active"`
+	Nodes       types.String           `tfsdk:"nodes"`
+	Connections types.String           `tfsdk:"connections"`
+	Settings    *settingsResourceModel `tfsdk:"settings"`
+	VersionId   types.String           `tfsdk:"version_id"`
+	CreatedAt   types.String           `tfsdk:"created_at"`
+	UpdatedAt   types.String           `tfsdk:"updated_at"`
+}
+
+// UpgradeState returns the state upgraders for every prior schema version,
+// keyed by the version they upgrade from. Each upgrader must produce state
+// matching the current schema directly.
+func (r *workflowResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := workflowResourceSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeWorkflowStateFromV0,
+		},
+	}
+}
+
+// upgradeWorkflowStateFromV0 migrates state written before schema versioning
+// was introduced. Existing values are carried over as is and attributes added
+// since then are set to their defaults.
+func upgradeWorkflowStateFromV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior workflowResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := workflowResourceModel{
+		ID:                prior.ID,
+		Name:              prior.Name,
+		Active:            prior.Active,
+		Nodes:             prior.Nodes,
+		Connections:       prior.Connections,
+		Settings:          prior.Settings,
+		VersionId:         prior.VersionId,
+		CreatedAt:         prior.CreatedAt,
+		UpdatedAt:         prior.UpdatedAt,
+		ContentHash:       types.StringNull(),
+		TriggerCount:      types.Int64Null(),
+		CalledWorkflowIDs: types.ListNull(types.StringType),
+
+		IgnoreEmptyParameters: types.BoolValue(false),
+		IgnoreStickyNotes:     types.BoolValue(false),
+		ReadOnly:              types.BoolValue(false),
+		AdoptExistingByName:   types.BoolValue(false),
+		VerifyActivation:      types.BoolValue(false),
+		ValidateOnPlan:        types.BoolValue(false),
+		WaitForWebhooks:       types.BoolValue(false),
+		Force:                 types.BoolValue(false),
+		NormalizeName:         types.BoolValue(false),
+		ActivateAfter:         types.ListNull(types.StringType),
+		SubworkflowOverrides:  types.MapNull(types.StringType),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
+
+// workflowResourceSchemaV0 returns the version 0 schema of the n8n_workflow
+// resource. Only the attribute types matter for decoding prior state, so plan
+// modifiers and defaults are omitted.
+func workflowResourceSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"active":      schema.BoolAttribute{Optional: true, Computed: true},
+			"nodes":       schema.StringAttribute{Required: true},
+			"connections": schema.StringAttribute{Required: true},
+			"settings": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"save_execution_progress":     schema.BoolAttribute{Optional: true, Computed: true},
+					"save_manual_executions":      schema.BoolAttribute{Optional: true, Computed: true},
+					"save_data_error_execution":   schema.StringAttribute{Optional: true, Computed: true},
+					"save_data_success_execution": schema.StringAttribute{Optional: true, Computed: true},
+					"execution_timeout":           schema.Int64Attribute{Optional: true, Computed: true},
+					"error_workflow":              schema.StringAttribute{Optional: true, Computed: true},
+					"timezone":                    schema.StringAttribute{Optional: true, Computed: true},
+					"execution_order":             schema.StringAttribute{Optional: true, Computed: true},
+				},
+			},
+			"version_id": schema.StringAttribute{Computed: true},
+			"created_at": schema.StringAttribute{Computed: true},
+			"updated_at": schema.StringAttribute{Computed: true},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorkflowResourceState returns an empty state using the current n8n_workflow schema.
+func newWorkflowResourceState(ctx context.Context, t *testing.T) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	NewWorkflowResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+func TestWorkflowResourceSchemaVersion(t *testing.T) {
+	ctx := context.Background()
+	state := newWorkflowResourceState(ctx, t)
+
+	require.Equal(t, workflowResourceSchemaVersion, state.Schema.GetVersion())
+
+	upgraders := (&workflowResource{}).UpgradeState(ctx)
+	for version := int64(0); version < workflowResourceSchemaVersion; version++ {
+		require.Contains(t, upgraders, version, "missing state upgrader for version %d", version)
+	}
+}
+
+func TestUpgradeWorkflowStateFromV0(t *testing.T) {
+	ctx := context.Background()
+
+	upgrader := (&workflowResource{}).UpgradeState(ctx)[0]
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+
+	priorModel := workflowResourceModelV0{
+		ID:          types.StringValue("3LODqkaWPmYOi0FA"),
+		Name:        types.StringValue("Test Workflow"),
+		Active:      types.BoolValue(true),
+		Nodes:       types.StringValue(`[{"id":"1","name":"Start"}]`),
+		Connections: types.StringValue(`{}`),
+		Settings: &settingsResourceModel{
+			SaveExecutionProgress:    types.BoolValue(true),
+			SaveManualExecutions:     types.BoolValue(true),
+			SaveDataErrorExecution:   types.StringValue("all"),
+			SaveDataSuccessExecution: types.StringValue("none"),
+			ExecutionTimeout:         types.Int64Value(3600),
+			ErrorWorkflow:            types.StringValue(""),
+			Timezone:                 types.StringValue("Europe/Berlin"),
+			ExecutionOrder:           types.StringValue("v1"),
+		},
+		VersionId: types.StringValue("version-1"),
+		CreatedAt: types.StringValue("2025-01-01T00:00:00.000Z"),
+		UpdatedAt: types.StringValue("2025-01-02T00:00:00.000Z"),
+	}
+	require.False(t, prior.Set(ctx, priorModel).HasError())
+
+	resp := resource.UpgradeStateResponse{State: newWorkflowResourceState(ctx, t)}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var upgraded workflowResourceModel
+	require.False(t, resp.State.Get(ctx, &upgraded).HasError())
+
+	require.Equal(t, priorModel.ID, upgraded.ID)
+	require.Equal(t, priorModel.Name, upgraded.Name)
+	require.Equal(t, priorModel.Active, upgraded.Active)
+	require.Equal(t, priorModel.Nodes, upgraded.Nodes)
+	require.Equal(t, priorModel.Connections, upgraded.Connections)
+	require.Equal(t, priorModel.Settings, upgraded.Settings)
+	require.Equal(t, priorModel.VersionId, upgraded.VersionId)
+	require.Equal(t, priorModel.CreatedAt, upgraded.CreatedAt)
+	require.Equal(t, priorModel.UpdatedAt, upgraded.UpdatedAt)
+	require.True(t, upgraded.TriggerCount.IsNull(), "trigger count is filled in by the next refresh")
+	require.Equal(t, types.BoolValue(false), upgraded.IgnoreEmptyParameters)
+	require.Equal(t, types.BoolValue(false), upgraded.IgnoreStickyNotes)
+	require.Equal(t, types.BoolValue(false), upgraded.ReadOnly)
+	require.Equal(t, types.BoolValue(false), upgraded.AdoptExistingByName)
+	require.Equal(t, types.BoolValue(false), upgraded.VerifyActivation)
+	require.Equal(t, types.BoolValue(false), upgraded.ValidateOnPlan)
+	require.Equal(t, types.BoolValue(false), upgraded.WaitForWebhooks)
+	require.Equal(t, types.BoolValue(false), upgraded.Force)
+	require.Equal(t, types.BoolValue(false), upgraded.NormalizeName)
+}
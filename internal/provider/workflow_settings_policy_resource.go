@@ -0,0 +1,313 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &workflowSettingsPolicyResource{}
+	_ resource.ResourceWithConfigure  = &workflowSettingsPolicyResource{}
+	_ resource.ResourceWithModifyPlan = &workflowSettingsPolicyResource{}
+)
+
+// NewWorkflowSettingsPolicyResource returns a new resource.
+func NewWorkflowSettingsPolicyResource() resource.Resource {
+	return &workflowSettingsPolicyResource{}
+}
+
+type workflowSettingsPolicyResource struct {
+	client *n8n.Client
+}
+
+// workflowSettingsPolicyResourceModel maps the resource schema data.
+type workflowSettingsPolicyResourceModel struct {
+	ID                      types.String           `tfsdk:"id"`
+	ProjectID               types.String           `tfsdk:"project_id"`
+	Tags                    types.List             `tfsdk:"tags"`
+	Settings                *settingsResourceModel `tfsdk:"settings"`
+	NonCompliantWorkflowIDs types.List             `tfsdk:"non_compliant_workflow_ids"`
+}
+
+func (r *workflowSettingsPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+func (r *workflowSettingsPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_settings_policy"
+}
+
+func (r *workflowSettingsPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enforces workflow settings across all workflows matching a selector by project and tags, without importing each workflow. Workflows that drifted from the policy are reported in the plan and updated on apply. Destroying the policy leaves the workflow settings as they are. Avoid policies on workflows also managed by n8n_workflow with different settings, as both would keep overwriting each other.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the policy, derived from its selector.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only enforce the policy on workflows of the project with this ID. Requires an n8n instance licensed for projects. When omitted, workflows of every project match.",
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only enforce the policy on workflows that have all of these tags. When both project_id and tags are omitted, the policy applies to every workflow.",
+			},
+			"settings": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Settings to enforce. Settings that are not set are left as they are.",
+				Attributes: map[string]schema.Attribute{
+					"save_execution_progress": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to save execution progress.",
+					},
+					"save_manual_executions": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to save manual executions.",
+					},
+					"save_data_error_execution": schema.StringAttribute{
+						Optional:    true,
+						Description: "Save behavior for error executions: 'all' or 'none'.",
+					},
+					"save_data_success_execution": schema.StringAttribute{
+						Optional:    true,
+						Description: "Save behavior for successful executions: 'all' or 'none'.",
+					},
+					"execution_timeout": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Execution timeout in seconds (max 3600), or -1 for no timeout.",
+					},
+					"error_workflow": schema.StringAttribute{
+						Optional:    true,
+						Description: "ID of the error handler workflow.",
+					},
+					"timezone": schema.StringAttribute{
+						Optional:    true,
+						Description: "Timezone for the workflow.",
+					},
+					"execution_order": schema.StringAttribute{
+						Optional:    true,
+						Description: "Execution order version: `v0` or `v1`.",
+						Validators: []validator.String{
+							StringOneOf("v0", "v1"),
+						},
+					},
+				},
+			},
+			"non_compliant_workflow_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the matching workflows whose settings differ from the policy. Always empty after apply.",
+			},
+		},
+	}
+}
+
+func (r *workflowSettingsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowSettingsPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.enforce(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSettingsPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workflowSettingsPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, workflows, err := r.matchingWorkflows(ctx, state.ProjectID, state.Tags)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	nonCompliant := []string{}
+	for _, workflow := range workflows {
+		if _, changed := applySettingsPolicy(workflow.Settings, state.Settings); changed {
+			nonCompliant = append(nonCompliant, workflow.ID)
+		}
+	}
+
+	state.ID = types.StringValue(workflowSelectorID(state.ProjectID.ValueString(), tags))
+	state.NonCompliantWorkflowIDs, diags = types.ListValueFrom(ctx, types.StringType, nonCompliant)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSettingsPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowSettingsPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.enforce(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSettingsPolicyResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	// Workflow settings are left as they are
+}
+
+// ModifyPlan plans an update whenever a matching workflow drifted from the
+// policy, so that the policy is enforced again on apply.
+func (r *workflowSettingsPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("non_compliant_workflow_ids"), types.ListValueMust(types.StringType, nil))...)
+
+	var projectID types.String
+	var tags types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() || projectID.IsUnknown() || tags.IsUnknown() {
+		return
+	}
+
+	var tagNames []string
+	resp.Diagnostics.Append(tags.ElementsAs(ctx, &tagNames, false)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringValue(workflowSelectorID(projectID.ValueString(), tagNames)))...)
+}
+
+// enforce updates the settings of every matching workflow that differs from
+// the policy.
+func (r *workflowSettingsPolicyResource) enforce(ctx context.Context, plan *workflowSettingsPolicyResourceModel, diagnostics *diag.Diagnostics) {
+	tags, workflows, err := r.matchingWorkflows(ctx, plan.ProjectID, plan.Tags)
+	if err != nil {
+		diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+
+	for _, workflow := range workflows {
+		settings, changed := applySettingsPolicy(workflow.Settings, plan.Settings)
+		if !changed {
+			continue
+		}
+
+		tflog.Debug(ctx, "Enforcing workflow settings policy", map[string]any{"id": workflow.ID})
+
+		_, err := r.client.UpdateWorkflow(workflow.ID, &n8n.UpdateWorkflowRequest{
+			Name:        workflow.Name,
+			Nodes:       workflow.Nodes,
+			Connections: workflow.Connections,
+			Settings:    settings,
+			Extra:       workflow.WritableExtra(),
+		})
+		if err != nil {
+			diagnostics.AddError("Error updating workflow settings", fmt.Sprintf("Workflow %s: %s", workflow.ID, err))
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(workflowSelectorID(plan.ProjectID.ValueString(), tags))
+	plan.NonCompliantWorkflowIDs = types.ListValueMust(types.StringType, nil)
+}
+
+// matchingWorkflows returns the tags of the selector and the workflows it
+// matches.
+func (r *workflowSettingsPolicyResource) matchingWorkflows(ctx context.Context, projectID types.String, tags types.List) ([]string, []n8n.Workflow, error) {
+	var tagNames []string
+	if diags := tags.ElementsAs(ctx, &tagNames, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("unable to read tags")
+	}
+
+	workflows, err := projectWorkflowsWithAllTags(r.client, projectID.ValueString(), tagNames)
+	return tagNames, workflows, err
+}
+
+// applySettingsPolicy returns the settings with the values set in the policy
+// applied, and whether they differ from the current settings.
+func applySettingsPolicy(current n8n.Settings, policy *settingsResourceModel) (n8n.Settings, bool) {
+	desired := current
+	if policy == nil {
+		return desired, false
+	}
+
+	if !policy.SaveExecutionProgress.IsNull() {
+		desired.SaveExecutionProgress = n8n.Ptr(policy.SaveExecutionProgress.ValueBool())
+	}
+	if !policy.SaveManualExecutions.IsNull() {
+		desired.SaveManualExecutions = n8n.Ptr(policy.SaveManualExecutions.ValueBool())
+	}
+	if !policy.SaveDataErrorExecution.IsNull() {
+		desired.SaveDataErrorExecution = n8n.Ptr(policy.SaveDataErrorExecution.ValueString())
+	}
+	if !policy.SaveDataSuccessExecution.IsNull() {
+		desired.SaveDataSuccessExecution = n8n.Ptr(policy.SaveDataSuccessExecution.ValueString())
+	}
+	if !policy.ExecutionTimeout.IsNull() {
+		desired.ExecutionTimeout = n8n.Ptr(int(policy.ExecutionTimeout.ValueInt64()))
+	}
+	if !policy.ErrorWorkflow.IsNull() {
+		desired.ErrorWorkflow = n8n.Ptr(policy.ErrorWorkflow.ValueString())
+	}
+	if !policy.Timezone.IsNull() {
+		desired.Timezone = n8n.Ptr(policy.Timezone.ValueString())
+	}
+	if !policy.ExecutionOrder.IsNull() {
+		desired.ExecutionOrder = n8n.Ptr(policy.ExecutionOrder.ValueString())
+	}
+
+	return desired, !reflect.DeepEqual(desired, current)
+}
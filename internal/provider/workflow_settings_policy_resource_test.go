@@ -0,0 +1,88 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplySettingsPolicy(t *testing.T) {
+	current := n8n.Settings{
+		SaveDataErrorExecution: n8n.Ptr("none"),
+		ExecutionTimeout:       n8n.Ptr(60),
+		Timezone:               n8n.Ptr("UTC"),
+	}
+
+	policy := &settingsResourceModel{
+		SaveExecutionProgress:    types.BoolNull(),
+		SaveManualExecutions:     types.BoolNull(),
+		SaveDataErrorExecution:   types.StringValue("all"),
+		SaveDataSuccessExecution: types.StringNull(),
+		ExecutionTimeout:         types.Int64Null(),
+		ErrorWorkflow:            types.StringNull(),
+		Timezone:                 types.StringNull(),
+		ExecutionOrder:           types.StringNull(),
+	}
+
+	desired, changed := applySettingsPolicy(current, policy)
+	assert.True(t, changed)
+	assert.Equal(t, "all", desired.GetSaveDataErrorExecution())
+	assert.Equal(t, 60, desired.GetExecutionTimeout(), "settings not in the policy are kept")
+	assert.Equal(t, "UTC", desired.GetTimezone(), "settings not in the policy are kept")
+
+	_, changed = applySettingsPolicy(desired, policy)
+	assert.False(t, changed, "compliant settings are not changed")
+
+	_, changed = applySettingsPolicy(current, nil)
+	assert.False(t, changed)
+}
+
+func TestEnforceSettingsPolicy(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	var projectIDs []string
+	server.Handle("GET /api/v1/workflows", func(w http.ResponseWriter, r *http.Request) {
+		projectIDs = append(projectIDs, r.URL.Query().Get("projectId"))
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "wf1", "name": "Agent", "tags": [{"name": "prod"}], "nodes": ` + editorNodes + `, "connections": ` + editorConnections + `, "settings": {"timezone": "UTC"}, "staticData": {"lastId": 42}, "shared": [], "isArchived": false},
+			{"id": "wf2", "name": "Orders", "tags": [], "settings": {"timezone": "UTC"}}
+		]}`))
+	})
+	server.Respond("PUT /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1"}`)
+	r := &workflowSettingsPolicyResource{client: server.Client()}
+
+	plan := &workflowSettingsPolicyResourceModel{
+		ProjectID: types.StringValue("p1"),
+		Tags:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+		Settings:  readManagedSettings(nil, n8n.Settings{}),
+	}
+	plan.Settings.Timezone = types.StringValue("Europe/Paris")
+
+	var diags diag.Diagnostics
+	r.enforce(context.Background(), plan, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"p1"}, projectIDs, "workflows are listed within the project")
+	assert.Equal(t, "project:p1/tags:prod", plan.ID.ValueString())
+
+	request := server.Requests("PUT /api/v1/workflows/wf1")[0]
+	assertEditorContentKept(t, request)
+
+	var sent map[string]json.RawMessage
+	request.DecodeBody(t, &sent)
+	var keys []string
+	for key := range sent {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"name", "nodes", "connections", "settings", "staticData"}, keys, "only the fields the API accepts are sent")
+}
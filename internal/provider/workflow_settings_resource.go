@@ -0,0 +1,272 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowSettingsResource{}
+	_ resource.ResourceWithConfigure   = &workflowSettingsResource{}
+	_ resource.ResourceWithImportState = &workflowSettingsResource{}
+)
+
+// NewWorkflowSettingsResource returns a new resource.
+func NewWorkflowSettingsResource() resource.Resource {
+	return &workflowSettingsResource{}
+}
+
+type workflowSettingsResource struct {
+	client *n8n.Client
+}
+
+// workflowSettingsResourceModel maps the resource schema data.
+type workflowSettingsResourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	WorkflowID types.String           `tfsdk:"workflow_id"`
+	Settings   *settingsResourceModel `tfsdk:"settings"`
+}
+
+func (r *workflowSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*resourceProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *resourceProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+func (r *workflowSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_settings"
+}
+
+func (r *workflowSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages only the settings of an existing workflow, leaving its nodes, connections and name to UI users. Destroying the resource leaves the settings as they are. Do not use it on workflows managed by n8n_workflow.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the workflow.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the workflow whose settings are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Settings to manage. Settings that are not set are left as they are and are not checked for drift.",
+				Attributes: map[string]schema.Attribute{
+					"save_execution_progress": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to save execution progress.",
+					},
+					"save_manual_executions": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to save manual executions.",
+					},
+					"save_data_error_execution": schema.StringAttribute{
+						Optional:    true,
+						Description: "Save behavior for error executions: 'all' or 'none'.",
+					},
+					"save_data_success_execution": schema.StringAttribute{
+						Optional:    true,
+						Description: "Save behavior for successful executions: 'all' or 'none'.",
+					},
+					"execution_timeout": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Execution timeout in seconds (max 3600), or -1 for no timeout.",
+					},
+					"error_workflow": schema.StringAttribute{
+						Optional:    true,
+						Description: "ID of the error handler workflow.",
+					},
+					"timezone": schema.StringAttribute{
+						Optional:    true,
+						Description: "Timezone for the workflow.",
+					},
+					"execution_order": schema.StringAttribute{
+						Optional:    true,
+						Description: "Execution order version: `v0` or `v1`.",
+						Validators: []validator.String{
+							StringOneOf("v0", "v1"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *workflowSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if refuseInReadOnlyMode(r.client, "created", &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workflowSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.GetWorkflow(state.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workflow", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(workflow.ID)
+	state.Settings = readManagedSettings(state.Settings, workflow.Settings)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if refuseInReadOnlyMode(r.client, "updated", &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *workflowSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if refuseInReadOnlyMode(r.client, "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	// Workflow settings are left as they are
+}
+
+func (r *workflowSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("workflow_id"), req, resp)
+}
+
+// apply writes the planned settings to the workflow when they differ from the
+// current ones. The workflow content, including the node fields and
+// connection types the provider does not model, and the other workflow fields
+// the update endpoint accepts are sent back unchanged.
+func (r *workflowSettingsResource) apply(ctx context.Context, plan *workflowSettingsResourceModel, diagnostics *diag.Diagnostics) {
+	workflow, err := r.client.GetWorkflow(plan.WorkflowID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Error reading workflow", err.Error())
+		return
+	}
+
+	settings, changed := applySettingsPolicy(workflow.Settings, plan.Settings)
+	if changed {
+		tflog.Debug(ctx, "Updating workflow settings", map[string]any{"id": workflow.ID})
+
+		_, err := r.client.UpdateWorkflow(workflow.ID, &n8n.UpdateWorkflowRequest{
+			Name:        workflow.Name,
+			Nodes:       workflow.Nodes,
+			Connections: workflow.Connections,
+			Settings:    settings,
+			Extra:       workflow.WritableExtra(),
+		})
+		if err != nil {
+			diagnostics.AddError("Error updating workflow settings", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(workflow.ID)
+}
+
+// readManagedSettings returns the current values of the settings managed in
+// state, leaving unmanaged settings null.
+func readManagedSettings(managed *settingsResourceModel, settings n8n.Settings) *settingsResourceModel {
+	result := &settingsResourceModel{
+		SaveExecutionProgress:    types.BoolNull(),
+		SaveManualExecutions:     types.BoolNull(),
+		SaveDataErrorExecution:   types.StringNull(),
+		SaveDataSuccessExecution: types.StringNull(),
+		ExecutionTimeout:         types.Int64Null(),
+		ErrorWorkflow:            types.StringNull(),
+		Timezone:                 types.StringNull(),
+		ExecutionOrder:           types.StringNull(),
+	}
+	if managed == nil {
+		return result
+	}
+
+	if !managed.SaveExecutionProgress.IsNull() {
+		result.SaveExecutionProgress = types.BoolValue(settings.GetSaveExecutionProgress())
+	}
+	if !managed.SaveManualExecutions.IsNull() {
+		result.SaveManualExecutions = types.BoolValue(settings.GetSaveManualExecutions())
+	}
+	if !managed.SaveDataErrorExecution.IsNull() {
+		result.SaveDataErrorExecution = types.StringValue(settings.GetSaveDataErrorExecution())
+	}
+	if !managed.SaveDataSuccessExecution.IsNull() {
+		result.SaveDataSuccessExecution = types.StringValue(settings.GetSaveDataSuccessExecution())
+	}
+	if !managed.ExecutionTimeout.IsNull() {
+		result.ExecutionTimeout = types.Int64Value(int64(settings.GetExecutionTimeout()))
+	}
+	if !managed.ErrorWorkflow.IsNull() {
+		result.ErrorWorkflow = types.StringValue(settings.GetErrorWorkflow())
+	}
+	if !managed.Timezone.IsNull() {
+		result.Timezone = types.StringValue(settings.GetTimezone())
+	}
+	if !managed.ExecutionOrder.IsNull() {
+		result.ExecutionOrder = types.StringValue(settings.GetExecutionOrder())
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadManagedSettings(t *testing.T) {
+	current := n8n.Settings{
+		SaveDataErrorExecution: n8n.Ptr("none"),
+		ExecutionTimeout:       n8n.Ptr(60),
+		Timezone:               n8n.Ptr("UTC"),
+	}
+
+	managed := readManagedSettings(nil, current)
+	managed.SaveDataErrorExecution = types.StringValue("all")
+	managed.ExecutionTimeout = types.Int64Value(3600)
+
+	result := readManagedSettings(managed, current)
+	assert.Equal(t, types.StringValue("none"), result.SaveDataErrorExecution, "managed settings are read from the server")
+	assert.Equal(t, types.Int64Value(60), result.ExecutionTimeout, "managed settings are read from the server")
+	assert.True(t, result.Timezone.IsNull(), "unmanaged settings stay null")
+	assert.True(t, result.SaveExecutionProgress.IsNull(), "unmanaged settings stay null")
+}
+
+// editorNodes and editorConnections are the content of a workflow edited in
+// the n8n editor, with node fields and connection types the provider does not
+// model.
+const (
+	editorNodes = `[{
+		"id": "1",
+		"name": "Agent",
+		"type": "@n8n/n8n-nodes-langchain.agent",
+		"typeVersion": 1.7,
+		"position": [0, 0],
+		"parameters": {},
+		"onError": "continueErrorOutput",
+		"retryOnFail": true,
+		"maxTries": 5,
+		"waitBetweenTries": 2000,
+		"alwaysOutputData": true
+	}, {
+		"id": "2",
+		"name": "Calculator",
+		"type": "@n8n/n8n-nodes-langchain.toolCalculator",
+		"typeVersion": 1,
+		"position": [0, 200],
+		"parameters": {}
+	}]`
+	editorConnections = `{"Calculator": {"ai_tool": [[{"node": "Agent", "type": "ai_tool", "index": 0}]]}}`
+)
+
+// assertEditorContentKept asserts that the body of a workflow update sends
+// back editorNodes and editorConnections unchanged.
+func assertEditorContentKept(t *testing.T, request n8ntest.Request) {
+	t.Helper()
+
+	var sent struct {
+		Nodes       json.RawMessage `json:"nodes"`
+		Connections json.RawMessage `json:"connections"`
+	}
+	request.DecodeBody(t, &sent)
+	assert.JSONEq(t, editorNodes, string(sent.Nodes), "unmodeled node fields are sent back")
+	assert.JSONEq(t, editorConnections, string(sent.Connections), "every connection type is sent back")
+}
+
+func TestWorkflowSettingsApplyKeepsContent(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Respond("GET /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1", "name": "Orders", "nodes": `+editorNodes+`, "connections": `+editorConnections+`, "settings": {"timezone": "UTC"}, "staticData": {"lastId": 42}, "shared": [], "isArchived": false, "meta": {"templateId": "42"}}`)
+	server.Respond("PUT /api/v1/workflows/wf1", http.StatusOK, `{"id": "wf1"}`)
+	r := &workflowSettingsResource{client: server.Client()}
+
+	plan := &workflowSettingsResourceModel{
+		WorkflowID: types.StringValue("wf1"),
+		Settings:   readManagedSettings(nil, n8n.Settings{}),
+	}
+	plan.Settings.Timezone = types.StringValue("Europe/Paris")
+
+	var diags diag.Diagnostics
+	r.apply(context.Background(), plan, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	request := server.Requests("PUT /api/v1/workflows/wf1")[0]
+	assertEditorContentKept(t, request)
+
+	var sent map[string]json.RawMessage
+	request.DecodeBody(t, &sent)
+	var keys []string
+	for key := range sent {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"name", "nodes", "connections", "settings", "staticData"}, keys, "only the fields the API accepts are sent")
+	assert.JSONEq(t, `{"timezone": "Europe/Paris"}`, string(sent["settings"]))
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowTemplateDataSource{}
+)
+
+// NewWorkflowTemplateDataSource returns a new data source.
+func NewWorkflowTemplateDataSource() datasource.DataSource {
+	return &workflowTemplateDataSource{}
+}
+
+type workflowTemplateDataSource struct {
+	client *n8n.Client
+}
+
+type workflowTemplateDataSourceModel struct {
+	TemplateID          types.Int64  `tfsdk:"template_id"`
+	TemplatesHost       types.String `tfsdk:"templates_host"`
+	Credentials         types.Map    `tfsdk:"credentials"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Nodes               types.String `tfsdk:"nodes"`
+	Connections         types.String `tfsdk:"connections"`
+	RequiredCredentials types.List   `tfsdk:"required_credentials"`
+}
+
+func (d *workflowTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *workflowTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_template"
+}
+
+func (d *workflowTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a workflow template from the n8n template library. Its `nodes` and `connections` can be passed to an n8n_workflow to instantiate the template.",
+		Attributes: map[string]schema.Attribute{
+			"template_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the template, as shown in its n8n.io URL.",
+			},
+			"templates_host": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the template library API. Defaults to `https://api.n8n.io`.",
+			},
+			"credentials": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the credentials to use, keyed by credential type such as `slackApi`. Credentials of other types are removed from the nodes and listed in `required_credentials`.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the template.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Markdown description of the template.",
+			},
+			"nodes": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded array of template nodes with the configured credentials applied, in canonical form.",
+			},
+			"connections": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded connections between template nodes, in canonical form.",
+			},
+			"required_credentials": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Credential types used by the template nodes that are missing from `credentials`.",
+			},
+		},
+	}
+}
+
+func (d *workflowTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workflowTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials := map[string]string{}
+	resp.Diagnostics.Append(state.Credentials.ElementsAs(ctx, &credentials, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templatesHost := n8n.DefaultTemplatesHostURL
+	if !state.TemplatesHost.IsNull() {
+		templatesHost = state.TemplatesHost.ValueString()
+	}
+
+	template, err := d.client.GetWorkflowTemplate(templatesHost, state.TemplateID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving workflow template", err.Error())
+		return
+	}
+
+	nodes, missing := applyTemplateCredentials(template.Workflow.Nodes, credentials)
+
+	nodesJSON, err := workflowCanonicalJSON(nodes)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to marshal nodes", err.Error())
+		return
+	}
+
+	connections := template.Workflow.Connections
+	if connections == nil {
+		connections = map[string]n8n.Connection{}
+	}
+	connectionsJSON, err := workflowCanonicalJSON(connections)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to marshal connections", err.Error())
+		return
+	}
+
+	requiredCredentials, diags := types.ListValueFrom(ctx, types.StringType, missing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Name = types.StringValue(template.Name)
+	state.Description = types.StringValue(template.Description)
+	state.Nodes = types.StringValue(nodesJSON)
+	state.Connections = types.StringValue(connectionsJSON)
+	state.RequiredCredentials = requiredCredentials
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applyTemplateCredentials returns a copy of the template nodes where the node
+// credentials reference the given credential IDs, keyed by credential type.
+// Credentials without a configured ID are removed, and their types are
+// returned sorted so that they can be created before instantiating the template.
+func applyTemplateCredentials(nodes []n8n.Node, credentials map[string]string) ([]n8n.Node, []string) {
+	missing := map[string]bool{}
+
+	result := make([]n8n.Node, len(nodes))
+	for i, node := range nodes {
+		result[i] = node
+		if len(node.Credentials) == 0 {
+			continue
+		}
+
+		nodeCredentials := make(map[string]interface{}, len(node.Credentials))
+		for credentialType, reference := range node.Credentials {
+			id, ok := credentials[credentialType]
+			if !ok {
+				missing[credentialType] = true
+				continue
+			}
+
+			name := credentialType
+			if existing, ok := reference.(map[string]interface{}); ok {
+				if existingName, ok := existing["name"].(string); ok && existingName != "" {
+					name = existingName
+				}
+			}
+			nodeCredentials[credentialType] = map[string]interface{}{"id": id, "name": name}
+		}
+
+		result[i].Credentials = nodeCredentials
+		if len(nodeCredentials) == 0 {
+			result[i].Credentials = nil
+		}
+	}
+
+	missingTypes := make([]string, 0, len(missing))
+	for credentialType := range missing {
+		missingTypes = append(missingTypes, credentialType)
+	}
+	sort.Strings(missingTypes)
+
+	return result, missingTypes
+}
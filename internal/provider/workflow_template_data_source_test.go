@@ -0,0 +1,39 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTemplateCredentials(t *testing.T) {
+	nodes := []n8n.Node{
+		{
+			Name: "Slack",
+			Credentials: map[string]interface{}{
+				"slackApi": map[string]interface{}{"id": "", "name": "Slack account"},
+			},
+		},
+		{
+			Name: "HTTP Request",
+			Credentials: map[string]interface{}{
+				"httpHeaderAuth": map[string]interface{}{"name": "Header Auth"},
+				"oAuth2Api":      map[string]interface{}{"name": "OAuth2"},
+			},
+		},
+		{Name: "Set"},
+	}
+
+	result, missing := applyTemplateCredentials(nodes, map[string]string{"slackApi": "42"})
+
+	assert.Equal(t, map[string]interface{}{"slackApi": map[string]interface{}{"id": "42", "name": "Slack account"}}, result[0].Credentials)
+	assert.Nil(t, result[1].Credentials, "unmapped credentials are removed")
+	assert.Nil(t, result[2].Credentials)
+	assert.Equal(t, []string{"httpHeaderAuth", "oAuth2Api"}, missing)
+
+	assert.Contains(t, nodes[1].Credentials, "httpHeaderAuth", "template nodes must not be modified")
+}
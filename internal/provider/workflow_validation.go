@@ -0,0 +1,103 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// validationWorkflowPrefix starts the names of the inactive copies created to
+// validate workflows at plan time.
+const validationWorkflowPrefix = "[terraform validation] "
+
+// validateOnPlan submits workflows with validate_on_plan set to the instance
+// when they are created or their content changes, so that n8n rejects invalid
+// nodes, connections or settings during the plan rather than the apply.
+func (r *workflowResource) validateOnPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan workflowResourceModel
+	if diags := resp.Plan.Get(ctx, &plan); diags.HasError() || !plan.ValidateOnPlan.ValueBool() || plan.ReadOnly.ValueBool() || plan.Nodes.IsUnknown() || plan.Connections.IsUnknown() {
+		return
+	}
+
+	// Only validate content that n8n has not accepted yet
+	if !req.State.Raw.IsNull() {
+		var state workflowResourceModel
+		if diags := req.State.Get(ctx, &state); diags.HasError() {
+			return
+		}
+		nodesOpts := jsonSemanticOptionsFromConfig(ctx, req.Config, "nodes")
+		connectionsOpts := jsonSemanticOptionsFromConfig(ctx, req.Config, "connections")
+		if !compareWorkflowContent(plan, state, nodesOpts, connectionsOpts).changed {
+			return
+		}
+	}
+
+	if plan.Endpoint != nil && (plan.Endpoint.Host.IsUnknown() || plan.Endpoint.Token.IsUnknown()) {
+		return
+	}
+	client, err := r.clientFor(ctx, plan.Endpoint)
+	if err != nil || client == nil {
+		return
+	}
+
+	// Validation stores a copy of the workflow, which read_only refuses
+	if client.ReadOnly {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("validate_on_plan"),
+			"Workflow not validated",
+			"validate_on_plan is ignored because the provider has read_only set, and validating the workflow requires creating a copy of it in n8n.",
+		)
+		return
+	}
+
+	r.validateOnInstance(ctx, client, plan, &resp.Diagnostics)
+}
+
+// validateOnInstance creates an inactive copy of the planned workflow and
+// deletes it right away, reporting the error n8n returns if it rejects the
+// workflow. The public API has no endpoint validating a workflow without
+// storing it. Invalid nodes or connections JSON is left to the apply to
+// report.
+func (r *workflowResource) validateOnInstance(ctx context.Context, client *n8n.Client, plan workflowResourceModel, diags *diag.Diagnostics) {
+	var nodes []n8n.Node
+	if err := json.Unmarshal([]byte(plan.Nodes.ValueString()), &nodes); err != nil {
+		return
+	}
+	var connections map[string]n8n.Connection
+	if err := json.Unmarshal([]byte(plan.Connections.ValueString()), &connections); err != nil {
+		return
+	}
+
+	name := r.workflowNames.apply(plan.Name.ValueString())
+	created, err := client.CreateWorkflow(&n8n.CreateWorkflowRequest{
+		Name:        validationWorkflowPrefix + name,
+		Nodes:       nodes,
+		Connections: connections,
+		Settings:    workflowSettingsFromModel(defaultWorkflowSettings(), plan.Settings),
+	})
+	if err != nil {
+		diags.AddError(
+			"Workflow rejected by n8n",
+			fmt.Sprintf("n8n rejected workflow %q during plan-time validation: %s", name, workflowWriteError(err, nodes)),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Validated workflow on the instance", map[string]any{"name": name, "validationId": created.ID})
+
+	if _, err := client.DeleteWorkflow(created.ID); err != nil {
+		diags.AddWarning(
+			"Unable to delete validation workflow",
+			fmt.Sprintf("Workflow %q was accepted by n8n, but the inactive copy %s created to validate it could not be deleted and should be removed by hand: %s", name, created.ID, err),
+		)
+	}
+}
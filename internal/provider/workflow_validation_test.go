@@ -0,0 +1,76 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/provider/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowValidateOnInstance(t *testing.T) {
+	server := n8ntest.NewServer(t)
+	server.Handle("POST /api/v1/workflows", func(w http.ResponseWriter, r *http.Request) {
+		if len(server.Requests("POST /api/v1/workflows")) > 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Unrecognized node type: n8n-nodes-community.unknown"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "tmp1", "active": false}`))
+	})
+	server.Respond("DELETE /api/v1/workflows/tmp1", http.StatusOK, `{"id": "tmp1"}`)
+	client := server.Client()
+
+	r := &workflowResource{client: client, workflowNames: workflowNamePolicy{prefix: "dev-"}}
+	plan := workflowResourceModel{
+		Name:        types.StringValue("Orders"),
+		Nodes:       types.StringValue(n8ntest.JSON(t, []n8n.Node{n8ntest.Webhook("Webhook", "orders", "POST")})),
+		Connections: types.StringValue(`{}`),
+	}
+
+	var diags diag.Diagnostics
+	r.validateOnInstance(context.Background(), client, plan, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, diags.Warnings())
+
+	var created n8n.CreateWorkflowRequest
+	server.Requests("POST /api/v1/workflows")[0].DecodeBody(t, &created)
+	assert.Equal(t, "[terraform validation] dev-Orders", created.Name)
+	assert.Len(t, server.Requests("DELETE /api/v1/workflows/tmp1"), 1, "the validation copy is deleted")
+
+	plan.Nodes = types.StringValue(n8ntest.JSON(t, []n8n.Node{n8ntest.Node("Custom", "n8n-nodes-community.unknown", nil)}))
+	diags = nil
+	r.validateOnInstance(context.Background(), client, plan, &diags)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), `The error likely concerns node "Custom"`)
+	assert.Len(t, server.Requests("DELETE /api/v1/workflows/tmp1"), 1, "rejected workflows are never stored")
+}
+
+func TestWorkflowValidateOnPlanReadOnly(t *testing.T) {
+	ctx := context.Background()
+	server := n8ntest.NewServer(t)
+	client := server.Client()
+	client.ReadOnly = true
+	r := &workflowResource{client: client}
+
+	model := testWorkflowResourceModel()
+	model.ID = types.StringUnknown()
+	model.ValidateOnPlan = types.BoolValue(true)
+	plan := newWorkflowResourcePlan(ctx, t, model)
+
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.validateOnPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: newWorkflowResourceState(ctx, t)}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "read_only")
+	assert.Empty(t, server.Requests("POST /api/v1/workflows"), "no copy is created")
+}
@@ -0,0 +1,237 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &workflowsByCredentialDataSource{}
+	_ datasource.DataSourceWithConfigure      = &workflowsByCredentialDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &workflowsByCredentialDataSource{}
+)
+
+// NewWorkflowsByCredentialDataSource returns a new data source.
+func NewWorkflowsByCredentialDataSource() datasource.DataSource {
+	return &workflowsByCredentialDataSource{}
+}
+
+type workflowsByCredentialDataSource struct {
+	client *n8n.Client
+}
+
+type workflowsByCredentialDataSourceModel struct {
+	CredentialID   types.String              `tfsdk:"credential_id"`
+	CredentialName types.String              `tfsdk:"credential_name"`
+	Tags           types.List                `tfsdk:"tags"`
+	IDs            types.List                `tfsdk:"ids"`
+	Workflows      []credentialWorkflowModel `tfsdk:"workflows"`
+}
+
+type credentialWorkflowModel struct {
+	ID    types.String   `tfsdk:"id"`
+	Name  types.String   `tfsdk:"name"`
+	Nodes []types.String `tfsdk:"nodes"`
+}
+
+// credentialWorkflow is a workflow referencing a credential, with the names
+// of the nodes using it.
+type credentialWorkflow struct {
+	ID    string
+	Name  string
+	Nodes []string
+}
+
+func (d *workflowsByCredentialDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ProviderData type", fmt.Sprintf("Expected *n8n.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *workflowsByCredentialDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflows_by_credential"
+}
+
+func (d *workflowsByCredentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the workflows whose nodes reference a credential, for example to know which workflows to test again after rotating a secret.",
+		Attributes: map[string]schema.Attribute{
+			"credential_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the credential. Exactly one of `credential_id` and `credential_name` must be set.",
+			},
+			"credential_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the credential, as stored in the node credential references. Exactly one of `credential_id` and `credential_name` must be set.",
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return workflows that have all of these tags.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted IDs of the workflows referencing the credential.",
+			},
+			"workflows": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Workflows referencing the credential, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Workflow ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the workflow.",
+						},
+						"nodes": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Names of the nodes using the credential.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workflowsByCredentialDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config workflowsByCredentialDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values may still be set once known
+	if config.CredentialID.IsUnknown() || config.CredentialName.IsUnknown() {
+		return
+	}
+
+	if config.CredentialID.IsNull() == config.CredentialName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_id"),
+			"Invalid credential selector",
+			"Exactly one of credential_id and credential_name must be set.",
+		)
+	}
+}
+
+func (d *workflowsByCredentialDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workflowsByCredentialDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagFilter []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &tagFilter, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Workflows are matched page by page rather than listed whole, as the
+	// nodes of every workflow of large instances weigh a lot
+	matches := []credentialWorkflow{}
+	err := d.client.ForEachWorkflow(ctx, tagListOptions(tagFilter), func(workflow n8n.Workflow) error {
+		if !workflowHasAllTags(workflow, tagFilter) {
+			return nil
+		}
+		if match, ok := workflowUsingCredential(workflow, state.CredentialID.ValueString(), state.CredentialName.ValueString()); ok {
+			matches = append(matches, match)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read n8n Workflows", err.Error())
+		return
+	}
+	sortCredentialWorkflows(matches)
+
+	ids := make([]string, 0, len(matches))
+	state.Workflows = make([]credentialWorkflowModel, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, match.ID)
+
+		nodes := make([]types.String, 0, len(match.Nodes))
+		for _, node := range match.Nodes {
+			nodes = append(nodes, types.StringValue(node))
+		}
+		state.Workflows = append(state.Workflows, credentialWorkflowModel{
+			ID:    types.StringValue(match.ID),
+			Name:  types.StringValue(match.Name),
+			Nodes: nodes,
+		})
+	}
+
+	state.IDs, diags = types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// workflowsUsingCredential returns the workflows with at least one node
+// referencing the credential with the given ID, or with the given name when
+// the ID is empty, ordered by workflow ID.
+func workflowsUsingCredential(workflows []n8n.Workflow, credentialID, credentialName string) []credentialWorkflow {
+	matches := []credentialWorkflow{}
+	for _, workflow := range workflows {
+		if match, ok := workflowUsingCredential(workflow, credentialID, credentialName); ok {
+			matches = append(matches, match)
+		}
+	}
+	sortCredentialWorkflows(matches)
+	return matches
+}
+
+// workflowUsingCredential returns the nodes of a workflow referencing the
+// credential with the given ID, or with the given name when the ID is empty,
+// and whether there is any.
+func workflowUsingCredential(workflow n8n.Workflow, credentialID, credentialName string) (credentialWorkflow, bool) {
+	var nodes []string
+	for _, reference := range nodeCredentialReferences(workflow.Nodes) {
+		if credentialID != "" && reference.ID != credentialID {
+			continue
+		}
+		if credentialID == "" && reference.Name != credentialName {
+			continue
+		}
+		// A node may use several credentials, report it once
+		if len(nodes) == 0 || nodes[len(nodes)-1] != reference.Node {
+			nodes = append(nodes, reference.Node)
+		}
+	}
+	return credentialWorkflow{ID: workflow.ID, Name: workflow.Name, Nodes: nodes}, len(nodes) > 0
+}
+
+// sortCredentialWorkflows orders matched workflows by workflow ID.
+func sortCredentialWorkflows(matches []credentialWorkflow) {
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].ID < matches[j].ID
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowsUsingCredential(t *testing.T) {
+	slack := map[string]interface{}{"slackApi": map[string]interface{}{"id": "2", "name": "Slack bot"}}
+	workflows := []n8n.Workflow{
+		{ID: "wf2", Name: "Alerts", Nodes: []n8n.Node{{Name: "Notify", Credentials: slack}, {Name: "Escalate", Credentials: slack}}},
+		{ID: "wf3", Name: "Billing", Nodes: []n8n.Node{{Name: "HTTP", Credentials: map[string]interface{}{"httpBasicAuth": map[string]interface{}{"id": "5"}}}}},
+		{ID: "wf1", Name: "Orders", Nodes: []n8n.Node{{Name: "Notify", Credentials: slack}}},
+	}
+
+	expected := []credentialWorkflow{
+		{ID: "wf1", Name: "Orders", Nodes: []string{"Notify"}},
+		{ID: "wf2", Name: "Alerts", Nodes: []string{"Escalate", "Notify"}},
+	}
+	assert.Equal(t, expected, workflowsUsingCredential(workflows, "2", ""))
+	assert.Equal(t, expected, workflowsUsingCredential(workflows, "", "Slack bot"))
+	assert.Empty(t, workflowsUsingCredential(workflows, "9", ""))
+}
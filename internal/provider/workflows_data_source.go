@@ -32,7 +32,12 @@ type workflowsDataSource struct {
 
 // workflowsDataSourceModel maps the data source schema data.
 type workflowsDataSourceModel struct {
-	Workflows []workflowsModel `tfsdk:"workflows"`
+	ProjectID         types.String              `tfsdk:"project_id"`
+	Tags              types.List                `tfsdk:"tags"`
+	EmptyIfUnlicensed types.Bool                `tfsdk:"empty_if_unlicensed"`
+	Workflows         []workflowsModel          `tfsdk:"workflows"`
+	WorkflowsByID     map[string]workflowsModel `tfsdk:"workflows_by_id"`
+	DuplicateNames    map[string][]string       `tfsdk:"duplicate_names"`
 }
 
 // workflowsModel maps workflows schema data.
@@ -44,6 +49,7 @@ type workflowsModel struct {
 	TriggerCount types.Int64    `tfsdk:"trigger_count"`
 	CreatedAt    types.String   `tfsdk:"created_at"`
 	UpdatedAt    types.String   `tfsdk:"updated_at"`
+	ContentHash  types.String   `tfsdk:"content_hash"`
 	Nodes        []nodesModel   `tfsdk:"nodes"`
 	Connections  types.String   `tfsdk:"connections"`
 	Settings     *settingsModel `tfsdk:"settings"`
@@ -116,48 +122,33 @@ func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of workflows.",
 		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the workflows of the project with this ID. Combined with `workflows_by_id` and an `import` block using `for_each`, this adopts every workflow of a project at once.",
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return workflows that have all of these tags.",
+			},
+			"empty_if_unlicensed": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Return no workflows with a warning, rather than failing, when the n8n instance is not licensed for the Enterprise feature the listing requires, such as projects for `project_id` on a community instance. Defaults to `false`.",
+			},
 			"workflows": schema.ListNestedAttribute{
-				Description: "List of workflows available in the system.",
+				Description:  "List of workflows available in the system.",
+				Computed:     true,
+				NestedObject: workflowsNestedObject(),
+			},
+			"workflows_by_id": schema.MapNestedAttribute{
+				Description:  "The same workflows keyed by workflow ID, for use with for_each.",
+				Computed:     true,
+				NestedObject: workflowsNestedObject(),
+			},
+			"duplicate_names": schema.MapAttribute{
+				Description: "IDs of the workflows sharing their name with another one, keyed by name. Duplicate names are usually operational mistakes, such as a workflow created twice.",
 				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Computed:    true,
-							Description: "Unique identifier of the workflow.",
-						},
-						"name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Name of the workflow.",
-						},
-						"active": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Indicates whether the workflow is currently active.",
-						},
-						"version_id": schema.StringAttribute{
-							Computed:    true,
-							Description: "Identifier of the current version of the workflow.",
-						},
-						"trigger_count": schema.Int64Attribute{
-							Computed:    true,
-							Description: "Number of times the workflow has been triggered.",
-						},
-						"created_at": schema.StringAttribute{
-							Computed:    true,
-							Description: "Timestamp when the workflow was created.",
-						},
-						"updated_at": schema.StringAttribute{
-							Computed:    true,
-							Description: "Timestamp when the workflow was last updated.",
-						},
-						"nodes": workflowsNodeAttr(),
-						"connections": schema.StringAttribute{
-							Computed:    true,
-							Description: "Raw JSON representation of connections between nodes.",
-						},
-						"settings": workflowsSettingsAttr(),
-						"tags":     workflowsTagsAttr(),
-					},
-				},
+				ElementType: types.ListType{ElemType: types.StringType},
 			},
 		},
 	}
@@ -166,18 +157,47 @@ func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 // Read refreshes the Terraform state with the latest data.
 func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state workflowsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagFilter []string
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &tagFilter, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	workflowsResponse, err := d.client.GetWorkflows()
+	var workflowsResponse *n8n.WorkflowsResponse
+	var err error
+	if state.ProjectID.IsNull() {
+		workflowsResponse, err = d.client.GetWorkflows()
+	} else {
+		workflowsResponse, err = d.client.GetProjectWorkflows(state.ProjectID.ValueString())
+	}
+	if feature, ok := n8n.RequiredLicense(err); ok && state.EmptyIfUnlicensed.ValueBool() {
+		resp.Diagnostics.AddWarning(licenseRequiredSummary, licenseRequiredDetail("Unable to Read n8n Workflows", feature, err)+"\n\nNo workflows are returned, as empty_if_unlicensed is set.")
+		workflowsResponse, err = &n8n.WorkflowsResponse{}, nil
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read n8n Workflows",
-			err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Unable to Read n8n Workflows", err)
 		return
 	}
 
-	// Map response body to model
+	var workflows []n8n.Workflow
 	for _, workflow := range workflowsResponse.Data {
+		if workflowHasAllTags(workflow, tagFilter) {
+			workflows = append(workflows, workflow)
+		}
+	}
+
+	state.DuplicateNames = duplicateWorkflowNames(workflows)
+
+	// Map response body to model
+	state.WorkflowsByID = make(map[string]workflowsModel)
+	for _, workflow := range workflows {
+
 		// Convert nodes
 		var nodes []nodesModel
 
@@ -226,6 +246,15 @@ func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadReque
 			return
 		}
 
+		contentHash, err := workflowContentHash(&workflow)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to hash workflow content",
+				err.Error(),
+			)
+			return
+		}
+
 		workflowState := workflowsModel{
 			ID:           types.StringValue(workflow.ID),
 			Name:         types.StringValue(workflow.Name),
@@ -234,26 +263,28 @@ func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadReque
 			TriggerCount: types.Int64Value(int64(workflow.TriggerCount)),
 			CreatedAt:    types.StringValue(workflow.CreatedAt),
 			UpdatedAt:    types.StringValue(workflow.UpdatedAt),
+			ContentHash:  types.StringValue(contentHash),
 			Nodes:        nodes,
 			Connections:  connectionsJSON,
 			Settings: &settingsModel{
-				SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-				SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-				SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-				SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-				ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-				ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-				Timezone:                 types.StringValue(workflow.Settings.Timezone),
-				ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
+				SaveExecutionProgress:    types.BoolValue(workflow.Settings.GetSaveExecutionProgress()),
+				SaveManualExecutions:     types.BoolValue(workflow.Settings.GetSaveManualExecutions()),
+				SaveDataErrorExecution:   types.StringValue(workflow.Settings.GetSaveDataErrorExecution()),
+				SaveDataSuccessExecution: types.StringValue(workflow.Settings.GetSaveDataSuccessExecution()),
+				ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.GetExecutionTimeout())),
+				ErrorWorkflow:            types.StringValue(workflow.Settings.GetErrorWorkflow()),
+				Timezone:                 types.StringValue(workflow.Settings.GetTimezone()),
+				ExecutionOrder:           types.StringValue(workflow.Settings.GetExecutionOrder()),
 			},
 			Tags: tags,
 		}
 
 		state.Workflows = append(state.Workflows, workflowState)
+		state.WorkflowsByID[workflow.ID] = workflowState
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -40,14 +40,14 @@ func TestWorkflowsDataSource(t *testing.T) {
 		},
 		Connections: map[string]n8n.Connection{},
 		Settings: n8n.Settings{
-			SaveExecutionProgress:    true,
-			SaveManualExecutions:     true,
-			SaveDataErrorExecution:   "all",
-			SaveDataSuccessExecution: "all",
-			ExecutionTimeout:         3600,
-			ErrorWorkflow:            "",
-			Timezone:                 "America/New_York",
-			ExecutionOrder:           "v1",
+			SaveExecutionProgress:    n8n.Ptr(true),
+			SaveManualExecutions:     n8n.Ptr(true),
+			SaveDataErrorExecution:   n8n.Ptr("all"),
+			SaveDataSuccessExecution: n8n.Ptr("all"),
+			ExecutionTimeout:         n8n.Ptr(3600),
+			ErrorWorkflow:            n8n.Ptr(""),
+			Timezone:                 n8n.Ptr("America/New_York"),
+			ExecutionOrder:           n8n.Ptr("v1"),
 		},
 	}
 
@@ -80,14 +80,14 @@ func TestWorkflowsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.version_id", createdWorkflow.VersionId),
 
 					// Settings
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_execution_progress", fmt.Sprintf("%t", createdWorkflow.Settings.SaveExecutionProgress)),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_manual_executions", fmt.Sprintf("%t", createdWorkflow.Settings.SaveManualExecutions)),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_data_error_execution", createdWorkflow.Settings.SaveDataErrorExecution),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_data_success_execution", createdWorkflow.Settings.SaveDataSuccessExecution),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.execution_timeout", fmt.Sprintf("%d", createdWorkflow.Settings.ExecutionTimeout)),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.error_workflow", createdWorkflow.Settings.ErrorWorkflow),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.timezone", createdWorkflow.Settings.Timezone),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.execution_order", createdWorkflow.Settings.ExecutionOrder),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_execution_progress", fmt.Sprintf("%t", createdWorkflow.Settings.GetSaveExecutionProgress())),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_manual_executions", fmt.Sprintf("%t", createdWorkflow.Settings.GetSaveManualExecutions())),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_data_error_execution", createdWorkflow.Settings.GetSaveDataErrorExecution()),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.save_data_success_execution", createdWorkflow.Settings.GetSaveDataSuccessExecution()),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.execution_timeout", fmt.Sprintf("%d", createdWorkflow.Settings.GetExecutionTimeout())),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.error_workflow", createdWorkflow.Settings.GetErrorWorkflow()),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.timezone", createdWorkflow.Settings.GetTimezone()),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.settings.execution_order", createdWorkflow.Settings.GetExecutionOrder()),
 
 					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.trigger_count", fmt.Sprintf("%d", createdWorkflow.TriggerCount)),
 					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.connections", "{}"),